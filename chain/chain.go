@@ -4,6 +4,7 @@ import (
 	"encoding/binary"
 	"encoding/json"
 	"fmt"
+	"io"
 	"math/big"
 	"os"
 
@@ -382,6 +383,16 @@ func ImportFromFile(filename string) (*Chain, error) {
 	return importChain(data)
 }
 
+// ImportFromReader imports a chain from the provided reader
+func ImportFromReader(r io.Reader) (*Chain, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return importChain(data)
+}
+
 func importChain(content []byte) (*Chain, error) {
 	var chain *Chain
 
@@ -15,7 +15,6 @@ import (
 	"github.com/0xPolygon/polygon-edge/command"
 	"github.com/0xPolygon/polygon-edge/command/helper"
 	"github.com/0xPolygon/polygon-edge/consensus/polybft"
-	"github.com/0xPolygon/polygon-edge/consensus/polybft/bitmap"
 	"github.com/0xPolygon/polygon-edge/consensus/polybft/contractsapi"
 	"github.com/0xPolygon/polygon-edge/consensus/polybft/contractsapi/artifact"
 	"github.com/0xPolygon/polygon-edge/consensus/polybft/validator"
@@ -124,6 +123,7 @@ func (p *genesisParams) generatePolyBftChainConfig(o command.OutputFormatter) er
 	}
 
 	polyBftConfig := &polybft.PolyBFTConfig{
+		ConfigVersion:       polybft.CurrentPolyBFTConfigVersion,
 		InitialValidatorSet: initialValidators,
 		BlockTime:           common.Duration{Duration: p.blockTime},
 		EpochSize:           p.epochSize,
@@ -203,24 +203,14 @@ func (p *genesisParams) generatePolyBftChainConfig(o command.OutputFormatter) er
 		}
 	}
 
-	validatorMetadata := make([]*validator.ValidatorMetadata, len(initialValidators))
-
-	for i, validator := range initialValidators {
-		// create validator metadata instance
-		metadata, err := validator.ToValidatorMetadata()
-		if err != nil {
-			return err
-		}
-
-		validatorMetadata[i] = metadata
-
-		// set genesis validators as boot nodes if boot nodes not provided via CLI
-		if len(p.bootnodes) == 0 {
+	// set genesis validators as boot nodes if boot nodes not provided via CLI
+	if len(p.bootnodes) == 0 {
+		for _, validator := range initialValidators {
 			chainConfig.Bootnodes = append(chainConfig.Bootnodes, validator.MultiAddr)
 		}
 	}
 
-	genesisExtraData, err := generateExtraDataPolyBft(validatorMetadata)
+	genesisExtraData, err := polyBftConfig.EncodeGenesisExtraData()
 	if err != nil {
 		return err
 	}
@@ -420,18 +410,6 @@ func (p *genesisParams) deployContracts(totalStake *big.Int,
 	return allocations, nil
 }
 
-// generateExtraDataPolyBft populates Extra with specific fields required for polybft consensus protocol
-func generateExtraDataPolyBft(validators []*validator.ValidatorMetadata) ([]byte, error) {
-	delta := &validator.ValidatorSetDelta{
-		Added:   validators,
-		Removed: bitmap.Bitmap{},
-	}
-
-	extra := polybft.Extra{Validators: delta, Checkpoint: &polybft.CheckpointData{}}
-
-	return extra.MarshalRLPTo(nil), nil
-}
-
 // getValidatorAccounts gathers validator accounts info either from CLI or from provided local storage
 func (p *genesisParams) getValidatorAccounts(
 	premineBalances map[types.Address]*premineInfo) ([]*validator.GenesisValidator, error) {
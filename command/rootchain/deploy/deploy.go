@@ -270,9 +270,6 @@ func runCommand(cmd *cobra.Command, _ []string) {
 		return
 	}
 
-	// populate bridge configuration
-	consensusConfig.Bridge = rootchainCfg.ToBridgeConfig()
-
 	// set event tracker start blocks for rootchain contract(s) of interest
 	blockNum, err := client.Eth().BlockNumber()
 	if err != nil {
@@ -281,9 +278,8 @@ func runCommand(cmd *cobra.Command, _ []string) {
 		return
 	}
 
-	consensusConfig.Bridge.EventTrackerStartBlocks = map[types.Address]uint64{
-		rootchainCfg.StateSenderAddress: blockNum,
-	}
+	// populate bridge configuration
+	consensusConfig.Bridge = newBridgeConfig(rootchainCfg, blockNum)
 
 	// write updated chain configuration
 	chainConfig.Params.ChainID = chainID
@@ -301,6 +297,22 @@ func runCommand(cmd *cobra.Command, _ []string) {
 	})
 }
 
+// newBridgeConfig creates the bridge configuration of the deployed rootchain contracts,
+// whose events are tracked from the given rootchain block
+func newBridgeConfig(rootchainCfg *polybft.RootchainConfig, startBlock uint64) *polybft.BridgeConfig {
+	bridge := rootchainCfg.ToBridgeConfig()
+
+	for _, addr := range []types.Address{
+		rootchainCfg.StateSenderAddress,
+		rootchainCfg.CheckpointManagerAddress,
+		rootchainCfg.ExitHelperAddress,
+	} {
+		bridge.SetStartBlock(addr, startBlock)
+	}
+
+	return bridge
+}
+
 // deployContracts deploys and initializes rootchain smart contracts
 func deployContracts(outputter command.OutputFormatter, client *jsonrpc.Client,
 	initialValidators []*validator.GenesisValidator, cmdCtx context.Context) (*polybft.RootchainConfig, int64, error) {
@@ -395,26 +407,14 @@ func deployContracts(outputter command.OutputFormatter, client *jsonrpc.Client,
 			name:     rootERC20PredicateName,
 			artifact: contractsapi.RootERC20Predicate,
 		},
-		{
-			name:     erc20TemplateName,
-			artifact: contractsapi.ChildERC20,
-		},
 		{
 			name:     rootERC721PredicateName,
 			artifact: contractsapi.RootERC721Predicate,
 		},
-		{
-			name:     erc721TemplateName,
-			artifact: contractsapi.ChildERC721,
-		},
 		{
 			name:     rootERC1155PredicateName,
 			artifact: contractsapi.RootERC1155Predicate,
 		},
-		{
-			name:     erc1155TemplateName,
-			artifact: contractsapi.ChildERC1155,
-		},
 		{
 			name:     stakeManagerName,
 			artifact: contractsapi.StakeManager,
@@ -425,44 +425,84 @@ func deployContracts(outputter command.OutputFormatter, client *jsonrpc.Client,
 		},
 	}
 
-	allContracts = append(tokenContracts, allContracts...)
+	// template contracts are deployed sequentially, ahead of any other deployer transaction,
+	// so that their addresses only depend on the deployer account and its nonce (see polybft.DefaultRootchainTemplates)
+	templateContracts := []*contractInfo{
+		{
+			name:     erc20TemplateName,
+			artifact: contractsapi.ChildERC20,
+		},
+		{
+			name:     erc721TemplateName,
+			artifact: contractsapi.ChildERC721,
+		},
+		{
+			name:     erc1155TemplateName,
+			artifact: contractsapi.ChildERC1155,
+		},
+	}
 
-	g, ctx := errgroup.WithContext(cmdCtx)
-	results := make([]*deployContractResult, len(allContracts))
+	allContracts = append(tokenContracts, allContracts...)
 
-	for i, contract := range allContracts {
-		i := i
-		contract := contract
+	deployContract := func(contract *contractInfo) (*deployContractResult, error) {
+		txn := &ethgo.Transaction{
+			To:    nil, // contract deployment
+			Input: contract.artifact.Bytecode,
+		}
 
-		g.Go(func() error {
-			select {
-			case <-ctx.Done():
-				return ctx.Err()
-			default:
-				txn := &ethgo.Transaction{
-					To:    nil, // contract deployment
-					Input: contract.artifact.Bytecode,
-				}
+		receipt, err := txRelayer.SendTransaction(txn, deployerKey)
+		if err != nil {
+			return nil, fmt.Errorf("failed sending %s contract deploy transaction: %w", contract.name, err)
+		}
 
-				receipt, err := txRelayer.SendTransaction(txn, deployerKey)
-				if err != nil {
-					return fmt.Errorf("failed sending %s contract deploy transaction: %w", contract.name, err)
-				}
+		if receipt == nil || receipt.Status != uint64(types.ReceiptSuccess) {
+			return nil, fmt.Errorf("deployment of %s contract failed", contract.name)
+		}
 
-				if receipt == nil || receipt.Status != uint64(types.ReceiptSuccess) {
-					return fmt.Errorf("deployment of %s contract failed", contract.name)
-				}
+		return newDeployContractsResult(contract.name,
+			types.Address(receipt.ContractAddress),
+			receipt.TransactionHash), nil
+	}
 
-				results[i] = newDeployContractsResult(contract.name,
-					types.Address(receipt.ContractAddress),
-					receipt.TransactionHash)
+	results := make([]*deployContractResult, len(templateContracts)+len(allContracts))
 
-				return nil
+	deployAll := func() error {
+		for i, contract := range templateContracts {
+			result, err := deployContract(contract)
+			if err != nil {
+				return err
 			}
-		})
+
+			results[i] = result
+		}
+
+		g, ctx := errgroup.WithContext(cmdCtx)
+
+		for i, contract := range allContracts {
+			i := i + len(templateContracts)
+			contract := contract
+
+			g.Go(func() error {
+				select {
+				case <-ctx.Done():
+					return ctx.Err()
+				default:
+					result, err := deployContract(contract)
+					if err != nil {
+						return err
+					}
+
+					results[i] = result
+
+					return nil
+				}
+			})
+		}
+
+		return g.Wait()
 	}
 
-	if err := g.Wait(); err != nil {
+	if err := deployAll(); err != nil {
 		_, _ = outputter.Write([]byte("[ROOTCHAIN - DEPLOY] Successfully deployed the following contracts\n"))
 
 		for _, result := range results {
@@ -487,7 +527,7 @@ func deployContracts(outputter command.OutputFormatter, client *jsonrpc.Client,
 		outputter.WriteCommandResult(result)
 	}
 
-	g, ctx = errgroup.WithContext(cmdCtx)
+	g, _ := errgroup.WithContext(cmdCtx)
 
 	for _, contract := range allContracts {
 		contract := contract
@@ -2,6 +2,7 @@ package deploy
 
 import (
 	"context"
+	"fmt"
 	"os"
 	"testing"
 
@@ -11,6 +12,7 @@ import (
 
 	"github.com/0xPolygon/polygon-edge/command"
 	"github.com/0xPolygon/polygon-edge/command/rootchain/helper"
+	"github.com/0xPolygon/polygon-edge/consensus/polybft"
 	"github.com/0xPolygon/polygon-edge/consensus/polybft/validator"
 	"github.com/0xPolygon/polygon-edge/types"
 )
@@ -37,8 +39,49 @@ func TestDeployContracts_NoPanics(t *testing.T) {
 
 	outputter := command.InitializeOutputter(GetCommand())
 
+	var rootchainConfig *polybft.RootchainConfig
+
 	require.NotPanics(t, func() {
-		_, _, err = deployContracts(outputter, client, []*validator.GenesisValidator{}, context.Background())
+		rootchainConfig, _, err = deployContracts(outputter, client, []*validator.GenesisValidator{}, context.Background())
 	})
 	require.NoError(t, err)
+
+	_, err = polybft.SplitValidationWarnings(newBridgeConfig(rootchainConfig, 0).Validate())
+	require.NoError(t, err)
+
+	// the test account deploys the rootchain from its first nonce
+	templates := polybft.DefaultRootchainTemplates(types.Address(testKey.Address()), 0)
+	require.Equal(t, templates.ERC20TemplateAddress, rootchainConfig.ERC20TemplateAddress)
+	require.Equal(t, templates.RootERC721TemplateAddress, rootchainConfig.RootERC721TemplateAddress)
+	require.Equal(t, templates.ERC1155TemplateAddress, rootchainConfig.ERC1155TemplateAddress)
+}
+
+func TestNewBridgeConfig_Validate(t *testing.T) {
+	t.Parallel()
+
+	// populate the rootchain config the same way as deployContracts does for the deployed contracts
+	rootchainConfig := &polybft.RootchainConfig{JSONRPCAddr: "http://127.0.0.1:8545"}
+
+	i := 0
+	for _, populatorFn := range metadataPopulatorMap {
+		i++
+		populatorFn(rootchainConfig, types.StringToAddress(fmt.Sprintf("0x%x", i)))
+	}
+
+	bridge := newBridgeConfig(rootchainConfig, 10)
+	require.Equal(t, uint64(10), bridge.EventTrackerStartBlocks[rootchainConfig.StateSenderAddress])
+
+	// the deployed predicates are not behind the ProxyAdmin, which is reported, but it doesn't invalidate the config
+	warnings, err := polybft.SplitValidationWarnings(bridge.Validate())
+	require.NoError(t, err)
+	require.Len(t, warnings, 1)
+	require.Equal(t, "proxyAdminAddress", warnings[0].Field)
+
+	config := polybft.MinimalValidPolyBFTConfig()
+	config.Bridge = bridge
+
+	warnings, err = polybft.SplitValidationWarnings(config.Validate())
+	require.NoError(t, err)
+	require.Len(t, warnings, 1)
+	require.Equal(t, "bridge.proxyAdminAddress", warnings[0].Field)
 }
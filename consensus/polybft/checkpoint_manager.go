@@ -62,6 +62,8 @@ type checkpointManager struct {
 	checkpointsOffset uint64
 	// checkpointManagerAddr is address of CheckpointManager smart contract
 	checkpointManagerAddr types.Address
+	// bridgeConfig is the configuration of the bridge the checkpoints are submitted to
+	bridgeConfig *BridgeConfig
 	// lastSentBlock represents the last block on which a checkpoint transaction was sent
 	lastSentBlock uint64
 	// logger instance
@@ -72,7 +74,7 @@ type checkpointManager struct {
 
 // newCheckpointManager creates a new instance of checkpointManager
 func newCheckpointManager(key ethgo.Key, checkpointOffset uint64,
-	checkpointManagerSC types.Address, txRelayer txrelayer.TxRelayer,
+	bridgeConfig *BridgeConfig, txRelayer txrelayer.TxRelayer,
 	blockchain blockchainBackend, backend polybftBackend, logger hclog.Logger,
 	state *State) *checkpointManager {
 	return &checkpointManager{
@@ -81,7 +83,8 @@ func newCheckpointManager(key ethgo.Key, checkpointOffset uint64,
 		consensusBackend:      backend,
 		rootChainRelayer:      txRelayer,
 		checkpointsOffset:     checkpointOffset,
-		checkpointManagerAddr: checkpointManagerSC,
+		checkpointManagerAddr: bridgeConfig.CheckpointManagerAddr,
+		bridgeConfig:          bridgeConfig,
 		logger:                logger,
 		state:                 state,
 	}
@@ -162,8 +165,9 @@ func (c *checkpointManager) submitCheckpoint(latestHeader *types.Header, isEndOf
 
 		parentEpochNumber := parentExtra.Checkpoint.EpochNumber
 		currentEpochNumber := currentExtra.Checkpoint.EpochNumber
-		// send pending checkpoints only for epoch ending blocks
-		if blockNumber == 1 || parentEpochNumber == currentEpochNumber {
+		// send pending checkpoints only for ending blocks of the checkpointed epochs
+		if blockNumber == 1 || parentEpochNumber == currentEpochNumber ||
+			!c.isCheckpointedEpochEnd(parentEpochNumber, parentExtra) {
 			parentHeader = currentHeader
 			parentExtra = currentExtra
 
@@ -265,9 +269,41 @@ func (c *checkpointManager) abiEncodeCheckpointBlock(blockNumber uint64, blockHa
 
 // isCheckpointBlock returns true for blocks in the middle of the epoch
 // which are offset by predefined count of blocks
-// or if given block is an epoch ending block
-func (c *checkpointManager) isCheckpointBlock(blockNumber uint64, isEpochEndingBlock bool) bool {
-	return isEpochEndingBlock || blockNumber == c.lastSentBlock+c.checkpointsOffset
+// or if given block is an ending block of the checkpointed epoch (see isCheckpointedEpochEnd)
+func (c *checkpointManager) isCheckpointBlock(header *types.Header, epoch uint64,
+	isEpochEndingBlock bool) (bool, error) {
+	if !isEpochEndingBlock {
+		return header.Number == c.lastSentBlock+c.checkpointsOffset, nil
+	}
+
+	if c.shouldCheckpointEpoch(epoch) {
+		return true, nil
+	}
+
+	extra, err := GetIbftExtra(header.ExtraData)
+	if err != nil {
+		return false, err
+	}
+
+	return c.isCheckpointedEpochEnd(epoch, extra), nil
+}
+
+// isCheckpointedEpochEnd checks whether the checkpoint of the ending block of the given epoch is submitted.
+// These are the ending blocks of the epochs checkpointed according to the bridge CheckpointInterval,
+// as well as the ones changing the validator set, since the rootchain verifies the subsequent checkpoints
+// against the validator set submitted by them. The skipped checkpoints are not submitted later on as pending ones.
+func (c *checkpointManager) isCheckpointedEpochEnd(epoch uint64, extra *Extra) bool {
+	if c.shouldCheckpointEpoch(epoch) {
+		return true
+	}
+
+	return extra.Validators != nil && !extra.Validators.IsEmpty()
+}
+
+// shouldCheckpointEpoch checks whether the given epoch is checkpointed according to the bridge CheckpointInterval
+// (see BridgeConfig.ShouldCheckpoint)
+func (c *checkpointManager) shouldCheckpointEpoch(epoch uint64) bool {
+	return c.bridgeConfig == nil || c.bridgeConfig.ShouldCheckpoint(epoch)
 }
 
 // PostBlock is called on every insert of finalized block (either from consensus or syncer)
@@ -300,8 +336,16 @@ func (c *checkpointManager) PostBlock(req *PostBlockRequest) error {
 		return err
 	}
 
-	if c.isCheckpointBlock(req.FullBlock.Block.Header.Number, req.IsEpochEndingBlock) &&
-		bytes.Equal(c.key.Address().Bytes(), req.FullBlock.Block.Header.Miner) {
+	if !bytes.Equal(c.key.Address().Bytes(), req.FullBlock.Block.Header.Miner) {
+		return nil
+	}
+
+	isCheckpointBlock, err := c.isCheckpointBlock(req.FullBlock.Block.Header, req.Epoch, req.IsEpochEndingBlock)
+	if err != nil {
+		return err
+	}
+
+	if isCheckpointBlock {
 		go func(header *types.Header, epochNumber uint64) {
 			if err := c.submitCheckpoint(header, req.IsEpochEndingBlock); err != nil {
 				c.logger.Warn("failed to submit checkpoint",
@@ -118,6 +118,78 @@ func TestCheckpointManager_SubmitCheckpoint(t *testing.T) {
 	}
 }
 
+func TestCheckpointManager_SubmitCheckpoint_CheckpointInterval(t *testing.T) {
+	t.Parallel()
+
+	const (
+		blocksCount        = 13
+		epochSize          = 2
+		checkpointInterval = 3
+	)
+
+	var aliases = []string{"A", "B", "C", "D", "E"}
+
+	validators := validator.NewTestValidatorsWithAliases(t, aliases)
+	txRelayerMock := newDummyTxRelayer(t)
+	txRelayerMock.On("Call", mock.Anything, mock.Anything, mock.Anything).
+		Return("1", error(nil)).
+		Once()
+	// send transactions for the ending blocks of the checkpointed epochs 3 and 6 (6 and 12), the ending block
+	// of the epoch 4 which changes the validator set (8) and the latest checkpoint block (13),
+	// while the ending blocks of the epochs 1, 2 and 5 (2, 4 and 10) are never sent
+	txRelayerMock.On("SendTransaction", mock.Anything, mock.Anything).
+		Return(&ethgo.Receipt{Status: uint64(types.ReceiptSuccess)}, error(nil)).
+		Times(4)
+
+	backendMock := new(polybftBackendMock)
+	backendMock.On("GetValidators", mock.Anything, mock.Anything).Return(validators.GetPublicIdentities())
+
+	var (
+		headersMap = &testHeadersMap{}
+		header     *types.Header
+		bitmap     bitmap.Bitmap
+		signatures bls.Signatures
+		idx        = uint64(0)
+		dummyMsg   = []byte("checkpoint")
+	)
+
+	validators.IterAcct(aliases, func(t *validator.TestValidator) {
+		bitmap.Set(idx)
+		signatures = append(signatures, t.MustSign(dummyMsg, bls.DomainCheckpointManager))
+		idx++
+	})
+
+	signature, err := signatures.Aggregate().Marshal()
+	require.NoError(t, err)
+
+	for i := uint64(1); i <= blocksCount; i++ {
+		if i%epochSize == 1 {
+			// epoch-beginning block
+			epochNumber := i/epochSize + 1
+			extra := createTestCheckpointExtra(epochNumber, epochNumber == 4)
+			extra.Committed = &Signature{Bitmap: bitmap, AggregatedSignature: signature}
+			header = &types.Header{ExtraData: extra.MarshalRLPTo(nil)}
+		} else {
+			header = header.Copy()
+		}
+
+		header.Number = i
+		header.ComputeHash()
+		headersMap.addHeader(header)
+	}
+
+	blockchainMock := new(blockchainMock)
+	blockchainMock.On("GetHeaderByNumber", mock.Anything).Return(headersMap.getHeader)
+
+	c := newCheckpointManager(wallet.NewEcdsaSigner(validators.GetValidator("A").Key()), blocksCount,
+		&BridgeConfig{CheckpointInterval: checkpointInterval}, txRelayerMock, blockchainMock, backendMock,
+		hclog.NewNullLogger(), nil)
+
+	require.NoError(t, c.submitCheckpoint(headersMap.getHeader(blocksCount), false))
+	txRelayerMock.AssertExpectations(t)
+	require.Equal(t, []uint64{6, 8, 12, 13}, txRelayerMock.checkpointBlocks)
+}
+
 func TestCheckpointManager_abiEncodeCheckpointBlock(t *testing.T) {
 	t.Parallel()
 
@@ -242,7 +314,10 @@ func TestCheckpointManager_IsCheckpointBlock(t *testing.T) {
 	cases := []struct {
 		name               string
 		blockNumber        uint64
+		epoch              uint64
 		checkpointsOffset  uint64
+		checkpointInterval uint64
+		validatorsChanged  bool
 		isEpochEndingBlock bool
 		isCheckpointBlock  bool
 	}{
@@ -274,6 +349,34 @@ func TestCheckpointManager_IsCheckpointBlock(t *testing.T) {
 			isEpochEndingBlock: true,
 			isCheckpointBlock:  true,
 		},
+		{
+			name:               "Epoch ending block - Checkpoint interval met",
+			blockNumber:        30,
+			epoch:              3,
+			checkpointsOffset:  30,
+			checkpointInterval: 3,
+			isEpochEndingBlock: true,
+			isCheckpointBlock:  true,
+		},
+		{
+			name:               "Epoch ending block - Checkpoint interval not met",
+			blockNumber:        20,
+			epoch:              2,
+			checkpointsOffset:  20,
+			checkpointInterval: 3,
+			isEpochEndingBlock: true,
+			isCheckpointBlock:  false,
+		},
+		{
+			name:               "Epoch ending block - Checkpoint interval not met, validator set changed",
+			blockNumber:        20,
+			epoch:              2,
+			checkpointsOffset:  20,
+			checkpointInterval: 3,
+			validatorsChanged:  true,
+			isEpochEndingBlock: true,
+			isCheckpointBlock:  true,
+		},
 	}
 
 	for _, c := range cases {
@@ -281,8 +384,16 @@ func TestCheckpointManager_IsCheckpointBlock(t *testing.T) {
 		t.Run(c.name, func(t *testing.T) {
 			t.Parallel()
 
-			checkpointMgr := newCheckpointManager(wallet.NewEcdsaSigner(createTestKey(t)), c.checkpointsOffset, types.ZeroAddress, nil, nil, nil, hclog.NewNullLogger(), nil)
-			require.Equal(t, c.isCheckpointBlock, checkpointMgr.isCheckpointBlock(c.blockNumber, c.isEpochEndingBlock))
+			header := &types.Header{
+				Number:    c.blockNumber,
+				ExtraData: createTestCheckpointExtra(c.epoch, c.validatorsChanged).MarshalRLPTo(nil),
+			}
+
+			checkpointMgr := newCheckpointManager(wallet.NewEcdsaSigner(createTestKey(t)), c.checkpointsOffset,
+				&BridgeConfig{CheckpointInterval: c.checkpointInterval}, nil, nil, nil, hclog.NewNullLogger(), nil)
+			isCheckpointBlock, err := checkpointMgr.isCheckpointBlock(header, c.epoch, c.isEpochEndingBlock)
+			require.NoError(t, err)
+			require.Equal(t, c.isCheckpointBlock, isCheckpointBlock)
 		})
 	}
 }
@@ -307,7 +418,7 @@ func TestCheckpointManager_PostBlock(t *testing.T) {
 	req := &PostBlockRequest{FullBlock: &types.FullBlock{Block: &types.Block{Header: &types.Header{Number: block}}, Receipts: receipts},
 		Epoch: epoch}
 
-	checkpointManager := newCheckpointManager(wallet.NewEcdsaSigner(createTestKey(t)), 5, types.ZeroAddress,
+	checkpointManager := newCheckpointManager(wallet.NewEcdsaSigner(createTestKey(t)), 5, &BridgeConfig{},
 		nil, nil, nil, hclog.NewNullLogger(), state)
 
 	t.Run("PostBlock - not epoch ending block", func(t *testing.T) {
@@ -405,7 +516,7 @@ func TestCheckpointManager_GenerateExitProof(t *testing.T) {
 	checkpointMgr := newCheckpointManager(wallet.NewEcdsaSigner(
 		createTestKey(t)),
 		0,
-		types.ZeroAddress,
+		&BridgeConfig{},
 		dummyTxRelayer,
 		nil,
 		nil,
@@ -512,6 +623,22 @@ func (d *dummyTxRelayer) Client() *jsonrpc.Client {
 	return nil
 }
 
+// createTestCheckpointExtra creates the extra of a block of the given epoch,
+// whose validator set delta is not empty if the validator set is changed
+func createTestCheckpointExtra(epoch uint64, validatorsChanged bool) *Extra {
+	delta := &validator.ValidatorSetDelta{}
+	if validatorsChanged {
+		delta.Removed.Set(0)
+	}
+
+	return &Extra{
+		Validators: delta,
+		Parent:     &Signature{},
+		Committed:  &Signature{},
+		Checkpoint: &CheckpointData{EpochNumber: epoch},
+	}
+}
+
 func getBlockNumberCheckpointSubmitInput(t *testing.T, input []byte) uint64 {
 	t.Helper()
 
@@ -23,6 +23,8 @@ import (
 )
 
 const (
+	// maxCommitmentSize is the default maximum number of state sync events per commitment,
+	// used unless the bridge configures its own (see BridgeConfig.MaxBridgeBatchSize)
 	maxCommitmentSize       = 10
 	stateFileName           = "consensusState.db"
 	commitEpochLookbackSize = 2 // number of blocks to calculate commit epoch info from the previous epoch
@@ -123,6 +125,11 @@ type consensusRuntime struct {
 
 // newConsensusRuntime creates and starts a new consensus runtime instance with event tracking
 func newConsensusRuntime(log hcf.Logger, config *runtimeConfig) (*consensusRuntime, error) {
+	// the bridge managers are run against the rootchain contracts of the served bridge
+	if err := config.PolyBFTConfig.checkServedBridgeRootchain(); err != nil {
+		return nil, fmt.Errorf("failed to create consensus runtime: %w", err)
+	}
+
 	proposerCalculator, err := NewProposerCalculator(config, log.Named("proposer_calculator"))
 	if err != nil {
 		return nil, fmt.Errorf("failed to create consensus runtime, error while creating proposer calculator %w", err)
@@ -163,21 +170,23 @@ func (c *consensusRuntime) close() {
 }
 
 // initStateSyncManager initializes state sync manager
-// if bridge is not enabled, then a dummy state sync manager will be used
+// if bridge is not enabled, then a dummy state sync manager will be used.
+// The blocks are posted to it only once the bridge is active (see isBridgeActiveAt).
 func (c *consensusRuntime) initStateSyncManager(logger hcf.Logger) error {
 	if c.IsBridgeEnabled() {
-		stateSenderAddr := c.config.PolyBFTConfig.Bridge.StateSenderAddr
+		bridge := c.config.PolyBFTConfig.PrimaryBridge()
+		stateSenderAddr := bridge.StateSenderAddr
 		stateSyncManager, err := newStateSyncManager(
 			logger.Named("state-sync-manager"),
 			c.config.State,
 			&stateSyncConfig{
 				key:                   c.config.Key,
 				stateSenderAddr:       stateSenderAddr,
-				stateSenderStartBlock: c.config.PolyBFTConfig.Bridge.EventTrackerStartBlocks[stateSenderAddr],
-				jsonrpcAddr:           c.config.PolyBFTConfig.Bridge.JSONRPCEndpoint,
+				stateSenderStartBlock: bridge.StartBlockFor(stateSenderAddr, logger),
+				jsonrpcAddr:           bridge.PrimaryEndpoint(),
 				dataDir:               c.config.DataDir,
 				topic:                 c.config.bridgeTopic,
-				maxCommitmentSize:     maxCommitmentSize,
+				maxCommitmentSize:     bridge.EffectiveBatchSize(),
 				numBlockConfirmations: c.config.numBlockConfirmations,
 			},
 		)
@@ -195,11 +204,14 @@ func (c *consensusRuntime) initStateSyncManager(logger hcf.Logger) error {
 }
 
 // initCheckpointManager initializes checkpoint manager
-// if bridge is not enabled, then a dummy checkpoint manager will be used
+// if bridge is not enabled, then a dummy checkpoint manager will be used.
+// The blocks are posted to it (i.e. the checkpoints are submitted) only once the bridge is active.
 func (c *consensusRuntime) initCheckpointManager(logger hcf.Logger) error {
 	if c.IsBridgeEnabled() {
 		// enable checkpoint manager
-		txRelayer, err := txrelayer.NewTxRelayer(txrelayer.WithIPAddress(c.config.PolyBFTConfig.Bridge.JSONRPCEndpoint))
+		bridge := c.config.PolyBFTConfig.PrimaryBridge()
+
+		txRelayer, err := txrelayer.NewTxRelayer(txrelayer.WithIPAddress(bridge.PrimaryEndpoint()))
 		if err != nil {
 			return err
 		}
@@ -207,7 +219,7 @@ func (c *consensusRuntime) initCheckpointManager(logger hcf.Logger) error {
 		c.checkpointManager = newCheckpointManager(
 			wallet.NewEcdsaSigner(c.config.Key),
 			defaultCheckpointsOffset,
-			c.config.PolyBFTConfig.Bridge.CheckpointManagerAddr,
+			bridge,
 			txRelayer,
 			c.config.blockchain,
 			c.config.polybftBackend,
@@ -222,7 +234,9 @@ func (c *consensusRuntime) initCheckpointManager(logger hcf.Logger) error {
 
 // initStakeManager initializes stake manager
 func (c *consensusRuntime) initStakeManager(logger hcf.Logger) error {
-	rootRelayer, err := txrelayer.NewTxRelayer(txrelayer.WithIPAddress(c.config.PolyBFTConfig.Bridge.JSONRPCEndpoint))
+	bridge := c.config.PolyBFTConfig.PrimaryBridge()
+
+	rootRelayer, err := txrelayer.NewTxRelayer(txrelayer.WithIPAddress(bridge.PrimaryEndpoint()))
 	if err != nil {
 		return err
 	}
@@ -233,8 +247,8 @@ func (c *consensusRuntime) initStakeManager(logger hcf.Logger) error {
 		rootRelayer,
 		wallet.NewEcdsaSigner(c.config.Key),
 		contracts.ValidatorSetContract,
-		c.config.PolyBFTConfig.Bridge.CustomSupernetManagerAddr,
-		int(c.config.PolyBFTConfig.MaxValidatorSetSize),
+		bridge.CustomSupernetManagerAddr,
+		c.config.PolyBFTConfig.MaxValidatorSetSizeAt,
 	)
 
 	return nil
@@ -261,8 +275,17 @@ func (c *consensusRuntime) getGuardedData() (guardedDataDTO, error) {
 	}, nil
 }
 
+// IsBridgeEnabled checks whether the runtime serves a bridge, that is whether the config resolves
+// the primary bridge (see PolyBFTConfig.PrimaryBridge), which the bridge managers are run for.
 func (c *consensusRuntime) IsBridgeEnabled() bool {
-	return c.config.PolyBFTConfig.IsBridgeEnabled()
+	return c.config.PolyBFTConfig.PrimaryBridge() != nil
+}
+
+// isBridgeActiveAt checks whether the bridge is active at the given block (see PolyBFTConfig.IsBridgeActiveAt).
+// The bridge managers are initialized whenever the bridge is enabled, but they are fed the blocks
+// and asked for the commitments only once the bridge is active.
+func (c *consensusRuntime) isBridgeActiveAt(block uint64) bool {
+	return c.config.PolyBFTConfig.IsBridgeActiveAt(block)
 }
 
 // OnBlockInserted is called whenever fsm or syncer inserts new block
@@ -294,14 +317,17 @@ func (c *consensusRuntime) OnBlockInserted(fullBlock *types.FullBlock) {
 
 	postBlock := &PostBlockRequest{FullBlock: fullBlock, Epoch: epoch.Number, IsEpochEndingBlock: isEndOfEpoch}
 
-	// handle commitment and proofs creation
-	if err := c.stateSyncManager.PostBlock(postBlock); err != nil {
-		c.logger.Error("failed to post block state sync", "err", err)
-	}
+	// the bridge managers are idle until the bridge gets activated
+	if c.isBridgeActiveAt(fullBlock.Block.Number()) {
+		// handle commitment and proofs creation
+		if err := c.stateSyncManager.PostBlock(postBlock); err != nil {
+			c.logger.Error("failed to post block state sync", "err", err)
+		}
 
-	// handle exit events that happened in block
-	if err := c.checkpointManager.PostBlock(postBlock); err != nil {
-		c.logger.Error("failed to post block in checkpoint manager", "err", err)
+		// handle exit events that happened in block
+		if err := c.checkpointManager.PostBlock(postBlock); err != nil {
+			c.logger.Error("failed to post block in checkpoint manager", "err", err)
+		}
 	}
 
 	// update proposer priorities
@@ -382,7 +408,7 @@ func (c *consensusRuntime) FSM() error {
 		logger:            c.logger.Named("fsm"),
 	}
 
-	if isEndOfSprint {
+	if isEndOfSprint && c.isBridgeActiveAt(pendingBlockNumber) {
 		commitment, err := c.stateSyncManager.Commitment()
 		if err != nil {
 			return err
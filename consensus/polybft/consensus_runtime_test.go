@@ -495,6 +495,68 @@ func Test_NewConsensusRuntime(t *testing.T) {
 	polybftBackendMock.AssertExpectations(t)
 }
 
+func Test_NewConsensusRuntime_BridgesOnly(t *testing.T) {
+	t.Parallel()
+
+	rootchainBridge := &BridgeConfig{
+		StateSenderAddr:           types.Address{0x13},
+		CheckpointManagerAddr:     types.Address{0x10},
+		CustomSupernetManagerAddr: types.Address{0x11},
+		JSONRPCEndpoint:           "testEndpoint",
+	}
+	polyBftConfig := &PolyBFTConfig{
+		Bridges:    map[uint64]*BridgeConfig{2: rootchainBridge},
+		EpochSize:  10,
+		SprintSize: 10,
+		BlockTime:  common.Duration{Duration: 2 * time.Second},
+	}
+
+	validators := validator.NewTestValidators(t, 3).GetPublicIdentities()
+
+	systemStateMock := new(systemStateMock)
+	systemStateMock.On("GetEpoch").Return(uint64(1)).Once()
+	systemStateMock.On("GetNextCommittedIndex").Return(uint64(1)).Once()
+
+	blockchainMock := &blockchainMock{}
+	blockchainMock.On("CurrentHeader").Return(&types.Header{Number: 1, ExtraData: createTestExtraForAccounts(t, 1, validators, nil)})
+	blockchainMock.On("GetStateProviderForBlock", mock.Anything).Return(new(stateProviderMock)).Once()
+	blockchainMock.On("GetSystemState", mock.Anything, mock.Anything).Return(systemStateMock).Once()
+	blockchainMock.On("GetHeaderByNumber", uint64(0)).Return(&types.Header{Number: 0, ExtraData: createTestExtraForAccounts(t, 0, validators, nil)})
+
+	polybftBackendMock := new(polybftBackendMock)
+	polybftBackendMock.On("GetValidators", mock.Anything, mock.Anything).Return(validators).Twice()
+
+	config := &runtimeConfig{
+		polybftBackend: polybftBackendMock,
+		State:          newTestState(t),
+		PolyBFTConfig:  polyBftConfig,
+		DataDir:        t.TempDir(),
+		Key:            createTestKey(t),
+		blockchain:     blockchainMock,
+		bridgeTopic:    &mockTopic{},
+	}
+
+	// the runtime serves the only rootchain bridge, although the legacy bridge isn't set
+	runtime, err := newConsensusRuntime(hclog.NewNullLogger(), config)
+	require.NoError(t, err)
+	require.Nil(t, runtime.config.PolyBFTConfig.Bridge)
+	require.True(t, runtime.IsBridgeEnabled())
+	require.True(t, runtime.isBridgeActiveAt(1))
+
+	stateSyncManager, ok := runtime.stateSyncManager.(*stateSyncManager)
+	require.True(t, ok)
+	require.Equal(t, rootchainBridge.StateSenderAddr, stateSyncManager.config.stateSenderAddr)
+	require.Equal(t, rootchainBridge.JSONRPCEndpoint, stateSyncManager.config.jsonrpcAddr)
+
+	checkpointManager, ok := runtime.checkpointManager.(*checkpointManager)
+	require.True(t, ok)
+	require.Same(t, rootchainBridge, checkpointManager.bridgeConfig)
+
+	stakeManager, ok := runtime.stakeManager.(*stakeManager)
+	require.True(t, ok)
+	require.Equal(t, rootchainBridge.CustomSupernetManagerAddr, stakeManager.supernetManagerContract)
+}
+
 func TestConsensusRuntime_restartEpoch_SameEpochNumberAsTheLastOne(t *testing.T) {
 	t.Parallel()
 
@@ -8,6 +8,7 @@ import (
 	"github.com/0xPolygon/polygon-edge/contracts"
 	"github.com/0xPolygon/polygon-edge/state"
 	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/go-hclog"
 	"github.com/umbracle/ethgo/abi"
 )
 
@@ -21,18 +22,25 @@ const (
 
 // getInitValidatorSetInput builds input parameters for ValidatorSet SC initialization
 func getInitValidatorSetInput(polyBFTConfig PolyBFTConfig) ([]byte, error) {
-	initialValidators := make([]*contractsapi.ValidatorInit, len(polyBFTConfig.InitialValidatorSet))
-	for i, validator := range polyBFTConfig.InitialValidatorSet {
+	activeValidators := polyBFTConfig.ActiveValidators()
+
+	initialValidators := make([]*contractsapi.ValidatorInit, len(activeValidators))
+	for i, validator := range activeValidators {
 		initialValidators[i] = &contractsapi.ValidatorInit{
 			Addr:  validator.Address,
 			Stake: validator.Stake,
 		}
 	}
 
+	rootChainManager := types.ZeroAddress
+	if bridge := polyBFTConfig.PrimaryBridge(); bridge != nil {
+		rootChainManager = bridge.CustomSupernetManagerAddr
+	}
+
 	initFn := &contractsapi.InitializeValidatorSetFn{
 		StateSender:      contracts.L2StateSenderContract,
 		StateReceiver:    contracts.StateReceiverContract,
-		RootChainManager: polyBFTConfig.Bridge.CustomSupernetManagerAddr,
+		RootChainManager: rootChainManager,
 		EpochSize_:       new(big.Int).SetUint64(polyBFTConfig.EpochSize),
 		InitalValidators: initialValidators,
 	}
@@ -40,13 +48,27 @@ func getInitValidatorSetInput(polyBFTConfig PolyBFTConfig) ([]byte, error) {
 	return initFn.EncodeAbi()
 }
 
-// getInitRewardPoolInput builds input parameters for RewardPool SC initialization
-func getInitRewardPoolInput(polybftConfig PolyBFTConfig) ([]byte, error) {
+// getInitRewardPoolInput builds input parameters for RewardPool SC initialization.
+// The rewards are distributed at the end of each epoch even if they are disabled by the missing reward config,
+// so the RewardPool is then initialized with zero base reward, paid by the RewardPool to itself
+// in the native token (transfers from the zero address or of the non-contract token are reverted).
+func getInitRewardPoolInput(polybftConfig PolyBFTConfig, logger hclog.Logger) ([]byte, error) {
+	if polybftConfig.RewardConfig == nil {
+		initFn := &contractsapi.InitializeRewardPoolFn{
+			RewardToken:  contracts.NativeERC20TokenContract,
+			RewardWallet: contracts.RewardPoolContract,
+			ValidatorSet: contracts.ValidatorSetContract,
+			BaseReward:   big.NewInt(0),
+		}
+
+		return initFn.EncodeAbi()
+	}
+
 	initFn := &contractsapi.InitializeRewardPoolFn{
 		RewardToken:  polybftConfig.RewardConfig.TokenAddress,
 		RewardWallet: polybftConfig.RewardConfig.WalletAddress,
 		ValidatorSet: contracts.ValidatorSetContract,
-		BaseReward:   new(big.Int).SetUint64(polybftConfig.EpochReward),
+		BaseReward:   polybftConfig.GenesisEpochReward(logger),
 	}
 
 	return initFn.EncodeAbi()
@@ -185,11 +207,18 @@ func getInitChildERC1155PredicateAccessListInput(config *BridgeConfig, owner typ
 	return params.EncodeAbi()
 }
 
-// mintRewardTokensToWalletAddress mints configured amount of reward tokens to reward wallet address
-func mintRewardTokensToWalletAddress(polyBFTConfig *PolyBFTConfig, transition *state.Transition) error {
+// approveRewardPool approves the RewardPool SC to spend the reward tokens of the reward wallet,
+// which is required for the reward distribution regardless of the reward source.
+// The unlimited reward wallet approves the maximum (uint256) amount, i.e. all of its tokens.
+func approveRewardPool(polyBFTConfig *PolyBFTConfig, transition *state.Transition) error {
+	amount := polyBFTConfig.RewardConfig.WalletAmount
+	if polyBFTConfig.RewardConfig.IsUnlimited() {
+		amount = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+	}
+
 	approveFn := &contractsapi.ApproveRootERC20Fn{
 		Spender: contracts.RewardPoolContract,
-		Amount:  polyBFTConfig.RewardConfig.WalletAmount,
+		Amount:  amount,
 	}
 
 	input, err := approveFn.EncodeAbi()
@@ -197,11 +226,12 @@ func mintRewardTokensToWalletAddress(polyBFTConfig *PolyBFTConfig, transition *s
 		return err
 	}
 
-	if err = initContract(polyBFTConfig.RewardConfig.WalletAddress,
-		polyBFTConfig.RewardConfig.TokenAddress, input, "RewardToken", transition); err != nil {
-		return err
-	}
+	return initContract(polyBFTConfig.RewardConfig.WalletAddress,
+		polyBFTConfig.RewardConfig.TokenAddress, input, "RewardToken", transition)
+}
 
+// mintRewardTokensToWalletAddress mints configured amount of reward tokens to reward wallet address
+func mintRewardTokensToWalletAddress(polyBFTConfig *PolyBFTConfig, transition *state.Transition) error {
 	if polyBFTConfig.RewardConfig.TokenAddress == contracts.NativeERC20TokenContract {
 		// if reward token is a native erc20 token, we don't need to mint an amount of tokens
 		// for given wallet address to it since this is done in premine
@@ -210,7 +240,7 @@ func mintRewardTokensToWalletAddress(polyBFTConfig *PolyBFTConfig, transition *s
 
 	mintFn := abi.MustNewMethod("function mint(address, uint256)")
 
-	input, err = mintFn.Encode([]interface{}{polyBFTConfig.RewardConfig.WalletAddress,
+	input, err := mintFn.Encode([]interface{}{polyBFTConfig.RewardConfig.WalletAddress,
 		polyBFTConfig.RewardConfig.WalletAmount})
 	if err != nil {
 		return err
@@ -1,9 +1,11 @@
 package polybft
 
 import (
+	"encoding/hex"
 	"fmt"
 	"math/big"
 
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/bitmap"
 	bls "github.com/0xPolygon/polygon-edge/consensus/polybft/signer"
 	"github.com/0xPolygon/polygon-edge/consensus/polybft/validator"
 	"github.com/0xPolygon/polygon-edge/crypto"
@@ -498,3 +500,63 @@ func GetIbftExtra(extraRaw []byte) (*Extra, error) {
 
 	return extra, nil
 }
+
+// EncodeGenesisExtraData encodes the initial validator set into the genesis block extra data,
+// i.e. Extra whose validator set delta adds all the active initial validators (with their stakes as voting powers).
+// The excluded validators are left out, the same way they are left out of the ValidatorSet SC initialization.
+func (p *PolyBFTConfig) EncodeGenesisExtraData() ([]byte, error) {
+	activeValidators := p.ActiveValidators()
+	validators := make([]*validator.ValidatorMetadata, len(activeValidators))
+
+	for i, v := range activeValidators {
+		metadata, err := v.ToValidatorMetadata()
+		if err != nil {
+			return nil, fmt.Errorf("invalid initial validator %s: %w", v.Address, err)
+		}
+
+		validators[i] = metadata
+	}
+
+	extra := &Extra{
+		Validators: &validator.ValidatorSetDelta{
+			Added:   validators,
+			Removed: bitmap.Bitmap{},
+		},
+		Checkpoint: &CheckpointData{},
+	}
+
+	return extra.MarshalRLPTo(nil), nil
+}
+
+// DecodeGenesisExtraData decodes the initial validator set from the genesis block extra data.
+// Only the address, BLS public key and stake (voting power) of the validators are encoded in the extra data,
+// so the balances and multi addresses of the returned validators are not populated.
+func DecodeGenesisExtraData(data []byte) ([]*validator.GenesisValidator, error) {
+	extra, err := GetIbftExtra(data)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(extra.Validators.Updated) > 0 {
+		return nil, fmt.Errorf("genesis extra data must not update validators (updated=%d)",
+			len(extra.Validators.Updated))
+	}
+
+	for i := uint64(0); i < extra.Validators.Removed.Len(); i++ {
+		if extra.Validators.Removed.IsSet(i) {
+			return nil, fmt.Errorf("genesis extra data must not remove validators (removed index=%d)", i)
+		}
+	}
+
+	validators := make([]*validator.GenesisValidator, len(extra.Validators.Added))
+
+	for i, v := range extra.Validators.Added {
+		validators[i] = &validator.GenesisValidator{
+			Address: v.Address,
+			BlsKey:  hex.EncodeToString(v.BlsKey.Marshal()),
+			Stake:   v.VotingPower,
+		}
+	}
+
+	return validators, nil
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/0xPolygon/polygon-edge/chain"
 	"github.com/0xPolygon/polygon-edge/consensus/polybft/bitmap"
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/contractsapi"
 	bls "github.com/0xPolygon/polygon-edge/consensus/polybft/signer"
 	"github.com/0xPolygon/polygon-edge/consensus/polybft/validator"
 	"github.com/0xPolygon/polygon-edge/consensus/polybft/wallet"
@@ -774,3 +775,73 @@ func TestCheckpointData_Copy(t *testing.T) {
 	copied.BlockRound = 10
 	require.NotEqual(t, original.BlockRound, copied.BlockRound)
 }
+
+func TestPolyBFTConfig_GenesisExtraData(t *testing.T) {
+	t.Parallel()
+
+	validators := validator.NewTestValidators(t, 3)
+
+	config := &PolyBFTConfig{InitialValidatorSet: validators.GetParamValidators()}
+
+	data, err := config.EncodeGenesisExtraData()
+	require.NoError(t, err)
+
+	extra, err := GetIbftExtra(data)
+	require.NoError(t, err)
+	require.Len(t, extra.Validators.Added, len(config.InitialValidatorSet))
+
+	decoded, err := DecodeGenesisExtraData(data)
+	require.NoError(t, err)
+	require.Len(t, decoded, len(config.InitialValidatorSet))
+
+	for i, v := range decoded {
+		expected := config.InitialValidatorSet[i]
+		require.Equal(t, expected.Address, v.Address)
+		require.Equal(t, expected.BlsKey, v.BlsKey)
+		require.Equal(t, expected.Stake, v.Stake)
+	}
+
+	// validator set deltas other than additions don't describe the genesis
+	extra.Validators.Removed.Set(1)
+	_, err = DecodeGenesisExtraData(extra.MarshalRLPTo(nil))
+	require.ErrorContains(t, err, "genesis extra data must not remove validators")
+
+	config.InitialValidatorSet[0].BlsKey = "invalid"
+	_, err = config.EncodeGenesisExtraData()
+	require.ErrorContains(t, err, "invalid initial validator "+config.InitialValidatorSet[0].Address.String())
+}
+
+func TestPolyBFTConfig_GenesisExtraDataExcludedValidators(t *testing.T) {
+	t.Parallel()
+
+	validators := validator.NewTestValidators(t, 4)
+
+	config := &PolyBFTConfig{
+		InitialValidatorSet: validators.GetParamValidators(),
+		EpochSize:           10,
+		Bridge:              &BridgeConfig{},
+	}
+	config.ExcludeValidator(config.InitialValidatorSet[1].Address)
+
+	data, err := config.EncodeGenesisExtraData()
+	require.NoError(t, err)
+
+	decoded, err := DecodeGenesisExtraData(data)
+	require.NoError(t, err)
+
+	input, err := getInitValidatorSetInput(*config)
+	require.NoError(t, err)
+
+	var initFn contractsapi.InitializeValidatorSetFn
+	require.NoError(t, initFn.DecodeAbi(input))
+
+	// the genesis header and the ValidatorSet SC must agree on the validator set
+	require.Len(t, decoded, len(config.InitialValidatorSet)-1)
+	require.Len(t, initFn.InitalValidators, len(decoded))
+
+	for i, v := range decoded {
+		require.False(t, config.IsValidatorExcluded(v.Address))
+		require.Equal(t, v.Address, initFn.InitalValidators[i].Addr)
+		require.Zero(t, v.Stake.Cmp(initFn.InitalValidators[i].Stake))
+	}
+}
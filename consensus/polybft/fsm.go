@@ -140,7 +140,7 @@ func (f *fsm) BuildProposal(currentRound uint64) ([]byte, error) {
 		}
 	}
 
-	if f.config.IsBridgeEnabled() {
+	if f.config.IsBridgeActiveAt(f.Height()) {
 		if err := f.applyBridgeCommitmentTx(); err != nil {
 			return nil, err
 		}
@@ -426,6 +426,10 @@ func (f *fsm) VerifyStateTransactions(transactions []*types.Transaction) error {
 				return fmt.Errorf("found commitment tx in block which should not contain it: tx = %v", tx.Hash)
 			}
 
+			if !f.config.IsBridgeActiveAt(f.Height()) {
+				return fmt.Errorf("found commitment tx in block before the bridge activation: tx = %v", tx.Hash)
+			}
+
 			if commitmentTxExists {
 				return fmt.Errorf("only one commitment tx is allowed per block: %v", tx.Hash)
 			}
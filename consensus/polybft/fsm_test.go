@@ -535,6 +535,8 @@ func TestFSM_VerifyStateTransactions_StateTransactionQuorumNotReached(t *testing
 	fsm := &fsm{
 		isEndOfEpoch:                 true,
 		isEndOfSprint:                true,
+		config:                       &PolyBFTConfig{Bridge: &BridgeConfig{}},
+		parent:                       &types.Header{},
 		validators:                   validatorSet,
 		proposerCommitmentToRegister: commitment,
 		commitEpochInput:             createTestCommitEpochInput(t, 0, 10),
@@ -577,6 +579,8 @@ func TestFSM_VerifyStateTransactions_StateTransactionInvalidSignature(t *testing
 	fsm := &fsm{
 		isEndOfEpoch:                 true,
 		isEndOfSprint:                true,
+		config:                       &PolyBFTConfig{Bridge: &BridgeConfig{}},
+		parent:                       &types.Header{},
 		validators:                   validatorSet,
 		proposerCommitmentToRegister: commitment,
 		commitEpochInput:             createTestCommitEpochInput(t, 0, 10),
@@ -1220,6 +1224,8 @@ func TestFSM_VerifyStateTransaction_ValidBothTypesOfStateTransactions(t *testing
 
 		f := &fsm{
 			isEndOfSprint: true,
+			config:        &PolyBFTConfig{Bridge: &BridgeConfig{}},
+			parent:        &types.Header{},
 			validators:    validators.ToValidatorSet(),
 		}
 
@@ -1242,6 +1248,42 @@ func TestFSM_VerifyStateTransaction_ValidBothTypesOfStateTransactions(t *testing
 	assert.ErrorContains(t, executeForValidators("A", "B", "C"), "quorum size not reached for state tx")
 }
 
+func TestFSM_VerifyStateTransaction_CommitmentBeforeBridgeActivation(t *testing.T) {
+	t.Parallel()
+
+	validators := validator.NewTestValidatorsWithAliases(t, []string{"A", "B", "C", "D"})
+	_, commitmentMessageSigned, _ := buildCommitmentAndStateSyncs(t, 10, uint64(3), 2)
+
+	hash, err := commitmentMessageSigned.Hash()
+	require.NoError(t, err)
+
+	signature := createSignature(t, validators.GetPrivateIdentities("A", "B", "C", "D"), hash, bls.DomainStateReceiver)
+	commitmentMessageSigned.AggSignature = *signature
+
+	inputData, err := commitmentMessageSigned.EncodeAbi()
+	require.NoError(t, err)
+
+	txns := []*types.Transaction{createStateTransactionWithData(contracts.StateReceiverContract, inputData)}
+
+	// the other rootchain bridge, which is active already, doesn't activate the served one
+	f := &fsm{
+		isEndOfSprint: true,
+		config: &PolyBFTConfig{
+			Bridge:  &BridgeConfig{BridgeActivationBlock: 20},
+			Bridges: map[uint64]*BridgeConfig{2: {BridgeActivationBlock: 10}},
+		},
+		parent:     &types.Header{Number: 18},
+		validators: validators.ToValidatorSet(),
+	}
+
+	// block 19 precedes the activation
+	require.ErrorContains(t, f.VerifyStateTransactions(txns), "found commitment tx in block before the bridge activation")
+
+	// block 20 is the activation block
+	f.parent = &types.Header{Number: 19}
+	require.NoError(t, f.VerifyStateTransactions(txns))
+}
+
 func TestFSM_VerifyStateTransaction_InvalidTypeOfStateTransactions(t *testing.T) {
 	t.Parallel()
 
@@ -1263,6 +1305,8 @@ func TestFSM_VerifyStateTransaction_QuorumNotReached(t *testing.T) {
 	_, commitmentMessageSigned, _ := buildCommitmentAndStateSyncs(t, 10, uint64(3), 2)
 	f := &fsm{
 		isEndOfSprint: true,
+		config:        &PolyBFTConfig{Bridge: &BridgeConfig{}},
+		parent:        &types.Header{},
 		validators:    validators.ToValidatorSet(),
 	}
 
@@ -1291,6 +1335,8 @@ func TestFSM_VerifyStateTransaction_InvalidSignature(t *testing.T) {
 	_, commitmentMessageSigned, _ := buildCommitmentAndStateSyncs(t, 10, uint64(3), 2)
 	f := &fsm{
 		isEndOfSprint: true,
+		config:        &PolyBFTConfig{Bridge: &BridgeConfig{}},
+		parent:        &types.Header{},
 		validators:    validators.ToValidatorSet(),
 	}
 
@@ -1329,6 +1375,8 @@ func TestFSM_VerifyStateTransaction_TwoCommitmentMessages(t *testing.T) {
 
 	f := &fsm{
 		isEndOfSprint: true,
+		config:        &PolyBFTConfig{Bridge: &BridgeConfig{}},
+		parent:        &types.Header{},
 		validators:    validatorSet,
 	}
 
@@ -0,0 +1,144 @@
+package polybft
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// semanticVersion is the parsed semantic version (https://semver.org), without the build metadata
+type semanticVersion struct {
+	major, minor, patch uint64
+	preRelease          []string
+}
+
+// parseSemanticVersion parses the semantic version, optionally prefixed with "v" (e.g. "v1.2.3-rc.1+build.5")
+func parseSemanticVersion(version string) (*semanticVersion, error) {
+	v := strings.TrimPrefix(strings.TrimSpace(version), "v")
+
+	// build metadata doesn't affect the precedence
+	if i := strings.IndexByte(v, '+'); i != -1 {
+		v = v[:i]
+	}
+
+	var preRelease []string
+
+	if i := strings.IndexByte(v, '-'); i != -1 {
+		preRelease = strings.Split(v[i+1:], ".")
+		v = v[:i]
+
+		for _, identifier := range preRelease {
+			if identifier == "" {
+				return nil, fmt.Errorf("invalid semantic version %q: empty pre-release identifier", version)
+			}
+		}
+	}
+
+	core := strings.Split(v, ".")
+	if len(core) != 3 {
+		return nil, fmt.Errorf("invalid semantic version %q: expected major.minor.patch", version)
+	}
+
+	numbers := make([]uint64, len(core))
+
+	for i, part := range core {
+		number, err := strconv.ParseUint(part, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid semantic version %q: %w", version, err)
+		}
+
+		numbers[i] = number
+	}
+
+	return &semanticVersion{major: numbers[0], minor: numbers[1], patch: numbers[2], preRelease: preRelease}, nil
+}
+
+// compare returns -1, 0 or 1 if the version precedes, equals or follows the other version respectively
+func (v *semanticVersion) compare(other *semanticVersion) int {
+	for _, pair := range [][2]uint64{{v.major, other.major}, {v.minor, other.minor}, {v.patch, other.patch}} {
+		if pair[0] != pair[1] {
+			if pair[0] < pair[1] {
+				return -1
+			}
+
+			return 1
+		}
+	}
+
+	// a pre-release version precedes the associated normal version
+	switch {
+	case len(v.preRelease) == 0 && len(other.preRelease) == 0:
+		return 0
+	case len(v.preRelease) == 0:
+		return 1
+	case len(other.preRelease) == 0:
+		return -1
+	}
+
+	for i := 0; i < len(v.preRelease) && i < len(other.preRelease); i++ {
+		if c := comparePreReleaseIdentifiers(v.preRelease[i], other.preRelease[i]); c != 0 {
+			return c
+		}
+	}
+
+	// a larger set of pre-release identifiers follows a smaller one, if all the preceding identifiers are equal
+	switch {
+	case len(v.preRelease) < len(other.preRelease):
+		return -1
+	case len(v.preRelease) > len(other.preRelease):
+		return 1
+	default:
+		return 0
+	}
+}
+
+// comparePreReleaseIdentifiers compares numeric identifiers numerically and the other ones lexically.
+// Numeric identifiers always precede the alphanumeric ones.
+func comparePreReleaseIdentifiers(a, b string) int {
+	aNumber, aErr := strconv.ParseUint(a, 10, 64)
+	bNumber, bErr := strconv.ParseUint(b, 10, 64)
+
+	switch {
+	case aErr == nil && bErr == nil:
+		if aNumber == bNumber {
+			return 0
+		} else if aNumber < bNumber {
+			return -1
+		}
+
+		return 1
+	case aErr == nil:
+		return -1
+	case bErr == nil:
+		return 1
+	default:
+		return strings.Compare(a, b)
+	}
+}
+
+// RequireNodeVersion checks that the given version of the running node is not older than MinNodeVersion.
+// Versions are compared as semantic versions, including the pre-release precedence (1.2.0-rc.1 < 1.2.0).
+// Nodes whose version can't be parsed (e.g. development builds without the version) are rejected
+// when MinNodeVersion is set.
+func (p *PolyBFTConfig) RequireNodeVersion(current string) error {
+	if p.MinNodeVersion == "" {
+		return nil
+	}
+
+	minVersion, err := parseSemanticVersion(p.MinNodeVersion)
+	if err != nil {
+		return fmt.Errorf("invalid minNodeVersion: %w", err)
+	}
+
+	currentVersion, err := parseSemanticVersion(current)
+	if err != nil {
+		return fmt.Errorf("node version can't be checked against minNodeVersion %s: %w", p.MinNodeVersion, err)
+	}
+
+	if currentVersion.compare(minVersion) < 0 {
+		return fmt.Errorf("node version %s is older than the minimum node version %s required by the config",
+			current, p.MinNodeVersion)
+	}
+
+	return nil
+}
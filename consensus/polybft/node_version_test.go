@@ -0,0 +1,68 @@
+package polybft
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSemanticVersion_Compare(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		a, b     string
+		expected int
+	}{
+		{"1.2.3", "1.2.3", 0},
+		{"v1.2.3", "1.2.3", 0},
+		{"1.2.3+build.1", "1.2.3+build.2", 0},
+		{"1.2.3", "1.2.4", -1},
+		{"1.10.0", "1.9.0", 1},
+		{"2.0.0", "1.99.99", 1},
+		{"1.0.0-alpha", "1.0.0", -1},
+		{"1.0.0-alpha", "1.0.0-alpha.1", -1},
+		{"1.0.0-alpha.1", "1.0.0-alpha.beta", -1},
+		{"1.0.0-alpha.beta", "1.0.0-beta", -1},
+		{"1.0.0-beta.2", "1.0.0-beta.11", -1},
+		{"1.0.0-beta.11", "1.0.0-rc.1", -1},
+		{"1.0.0-rc.1", "1.0.0", -1},
+	}
+
+	for _, c := range cases {
+		a, err := parseSemanticVersion(c.a)
+		require.NoError(t, err)
+
+		b, err := parseSemanticVersion(c.b)
+		require.NoError(t, err)
+
+		require.Equal(t, c.expected, a.compare(b), "%s vs %s", c.a, c.b)
+		require.Equal(t, -c.expected, b.compare(a), "%s vs %s", c.b, c.a)
+	}
+}
+
+func TestParseSemanticVersion_Invalid(t *testing.T) {
+	t.Parallel()
+
+	for _, version := range []string{"", "1.2", "1.2.3.4", "1.x.3", "1.2.3-", "1.2.3-alpha..1", "Devel"} {
+		_, err := parseSemanticVersion(version)
+		require.Error(t, err, version)
+	}
+}
+
+func TestPolyBFTConfig_RequireNodeVersion(t *testing.T) {
+	t.Parallel()
+
+	config := &PolyBFTConfig{}
+	require.NoError(t, config.RequireNodeVersion(""))
+
+	config.MinNodeVersion = "1.3.0"
+	require.NoError(t, config.RequireNodeVersion("1.3.0"))
+	require.NoError(t, config.RequireNodeVersion("v1.4.0-rc.1"))
+	require.ErrorContains(t, config.RequireNodeVersion("1.3.0-rc.2"), "is older than the minimum node version 1.3.0")
+	require.ErrorContains(t, config.RequireNodeVersion("1.2.9"), "is older than the minimum node version 1.3.0")
+	require.ErrorContains(t, config.RequireNodeVersion(""), "can't be checked against minNodeVersion")
+
+	config.MinNodeVersion = "latest"
+	require.ErrorContains(t, config.RequireNodeVersion("1.3.0"), "invalid minNodeVersion")
+	require.ErrorContains(t, config.Validate(), "minNodeVersion: invalid semantic version")
+}
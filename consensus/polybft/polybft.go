@@ -20,6 +20,7 @@ import (
 	"github.com/0xPolygon/polygon-edge/state"
 	"github.com/0xPolygon/polygon-edge/syncer"
 	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/0xPolygon/polygon-edge/versioning"
 	"github.com/hashicorp/go-hclog"
 )
 
@@ -54,11 +55,27 @@ func Factory(params *consensus.Params) (consensus.Consensus, error) {
 		return nil, err
 	}
 
-	err = json.Unmarshal(customConfigJSON, &polybft.consensusConfig)
+	consensusConfig, err := MigratePolyBFTConfig(customConfigJSON)
 	if err != nil {
 		return nil, err
 	}
 
+	polybft.consensusConfig = &consensusConfig
+
+	if err := applyBridgeJSONRPCEndpointOverride(polybft.consensusConfig, logger); err != nil {
+		return nil, fmt.Errorf("invalid polybft consensus configuration: %w", err)
+	}
+
+	// sprint size is used as a divisor when checking for the sprint end, so refuse to start rather than panic
+	if polybft.consensusConfig.SprintSize == 0 {
+		return nil, fmt.Errorf("invalid polybft consensus configuration: %w", ErrZeroSprintSize)
+	}
+
+	// refuse to run the config which relies on the features this node doesn't have
+	if err := polybft.consensusConfig.RequireNodeVersion(versioning.Version); err != nil {
+		return nil, fmt.Errorf("invalid polybft consensus configuration: %w", err)
+	}
+
 	return polybft, nil
 }
 
@@ -119,6 +136,11 @@ func GenesisPostHookFactory(config *chain.Chain, engineName string) func(txn *st
 			return err
 		}
 
+		// the child chain predicates are initialized with the rootchain contracts of the served bridge
+		if err := polyBFTConfig.checkServedBridgeRootchain(); err != nil {
+			return err
+		}
+
 		// initialize ValidatorSet SC
 		input, err := getInitValidatorSetInput(polyBFTConfig)
 		if err != nil {
@@ -130,12 +152,27 @@ func GenesisPostHookFactory(config *chain.Chain, engineName string) func(txn *st
 			return err
 		}
 
-		if err = mintRewardTokensToWalletAddress(&polyBFTConfig, transition); err != nil {
-			return err
+		// there is no reward wallet to approve nor fund if the rewards are disabled by the missing reward config
+		if rewardConfig := polyBFTConfig.RewardConfig; rewardConfig != nil {
+			// the RewardPool pays the rewards from the reward wallet whatever the reward source is,
+			// hence it must be allowed to spend the wallet tokens
+			if rewardConfig.WalletAddress != types.ZeroAddress {
+				if err = approveRewardPool(&polyBFTConfig, transition); err != nil {
+					return err
+				}
+			}
+
+			// rewards paid solely from the transaction fees don't need the wallet to be funded at genesis,
+			// neither does the unlimited reward wallet, whose tokens are minted by the native token minter
+			if rewardConfig.RequiresFundedWallet() && !rewardConfig.IsUnlimited() {
+				if err = mintRewardTokensToWalletAddress(&polyBFTConfig, transition); err != nil {
+					return err
+				}
+			}
 		}
 
 		// initialize RewardPool SC
-		input, err = getInitRewardPoolInput(polyBFTConfig)
+		input, err = getInitRewardPoolInput(polyBFTConfig, hclog.Default())
 		if err != nil {
 			return err
 		}
@@ -157,7 +194,9 @@ func GenesisPostHookFactory(config *chain.Chain, engineName string) func(txn *st
 			bridgeBlockListAdmin = config.Params.BridgeBlockList.AdminAddresses[0]
 		}
 
-		// initialize Predicate SCs
+		// initialize Predicate SCs for the bridge served by the node
+		bridge := polyBFTConfig.PrimaryBridge()
+
 		if bridgeAllowListAdmin != types.ZeroAddress || bridgeBlockListAdmin != types.ZeroAddress {
 			// The owner of the contract will be the allow list admin or the block list admin, if any of them is set.
 			owner := contracts.SystemCaller
@@ -167,7 +206,7 @@ func GenesisPostHookFactory(config *chain.Chain, engineName string) func(txn *st
 				owner = bridgeBlockListAdmin
 			}
 
-			input, err = getInitChildERC20PredicateAccessListInput(polyBFTConfig.Bridge, owner)
+			input, err = getInitChildERC20PredicateAccessListInput(bridge, owner)
 			if err != nil {
 				return err
 			}
@@ -177,7 +216,7 @@ func GenesisPostHookFactory(config *chain.Chain, engineName string) func(txn *st
 				return err
 			}
 
-			input, err = getInitChildERC721PredicateAccessListInput(polyBFTConfig.Bridge, owner)
+			input, err = getInitChildERC721PredicateAccessListInput(bridge, owner)
 			if err != nil {
 				return err
 			}
@@ -187,7 +226,7 @@ func GenesisPostHookFactory(config *chain.Chain, engineName string) func(txn *st
 				return err
 			}
 
-			input, err = getInitChildERC1155PredicateAccessListInput(polyBFTConfig.Bridge, owner)
+			input, err = getInitChildERC1155PredicateAccessListInput(bridge, owner)
 			if err != nil {
 				return err
 			}
@@ -197,7 +236,7 @@ func GenesisPostHookFactory(config *chain.Chain, engineName string) func(txn *st
 				return err
 			}
 		} else {
-			input, err = getInitChildERC20PredicateInput(polyBFTConfig.Bridge)
+			input, err = getInitChildERC20PredicateInput(bridge)
 			if err != nil {
 				return err
 			}
@@ -208,7 +247,7 @@ func GenesisPostHookFactory(config *chain.Chain, engineName string) func(txn *st
 			}
 
 			// initialize ChildERC721Predicate SC
-			input, err = getInitChildERC721PredicateInput(polyBFTConfig.Bridge)
+			input, err = getInitChildERC721PredicateInput(bridge)
 			if err != nil {
 				return err
 			}
@@ -219,7 +258,7 @@ func GenesisPostHookFactory(config *chain.Chain, engineName string) func(txn *st
 			}
 
 			// initialize ChildERC1155Predicate SC
-			input, err = getInitChildERC1155PredicateInput(polyBFTConfig.Bridge)
+			input, err = getInitChildERC1155PredicateInput(bridge)
 			if err != nil {
 				return err
 			}
@@ -230,12 +269,9 @@ func GenesisPostHookFactory(config *chain.Chain, engineName string) func(txn *st
 			}
 		}
 
-		rootNativeERC20Token := types.ZeroAddress
-		if polyBFTConfig.Bridge != nil {
-			rootNativeERC20Token = polyBFTConfig.Bridge.RootNativeERC20Addr
-		}
+		rootNativeERC20Token := polyBFTConfig.rootNativeERC20Addr()
 
-		if polyBFTConfig.NativeTokenConfig.IsMintable {
+		if polyBFTConfig.NativeTokenMode() == NativeTokenModeMintable {
 			// initialize NativeERC20Mintable SC
 			params := &contractsapi.InitializeNativeERC20MintableFn{
 				Predicate_: contracts.ChildERC20PredicateContract,
@@ -1,197 +1,1512 @@
 package polybft
 
 import (
+	"bytes"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
 	"math/big"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/0xPolygon/polygon-edge/chain"
 	"github.com/0xPolygon/polygon-edge/consensus/polybft/validator"
 	"github.com/0xPolygon/polygon-edge/helper/common"
 	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/go-multierror"
 )
 
-const ConsensusName = "polybft"
+const (
+	ConsensusName = "polybft"
+
+	// maxTokenDecimals is the maximum number of decimals of the native token
+	maxTokenDecimals = 18
+	// defaultNativeTokenDecimals is the number of decimals assumed when the native token config is missing
+	defaultNativeTokenDecimals = 18
+	// maxTokenSymbolLength is the maximum length of the native token symbol
+	maxTokenSymbolLength = 11
+	// maxTokenAmountExponent is the maximum absolute value of the exponent accepted by ParseTokenAmount
+	maxTokenAmountExponent = 78
+	// maxBasisPoints is the number of basis points which make up 100%
+	maxBasisPoints = 10000
+
+	// yearDuration is the duration of a (non-leap) year, used for the annual reward projections
+	yearDuration = 365 * 24 * time.Hour
+	// redactedEndpoint replaces the JSON RPC endpoints which can't be redacted selectively
+	redactedEndpoint = "<redacted>"
+	// defaultCheckpointInterval is the default number of epochs between two checkpoints
+	defaultCheckpointInterval = 1
+	// firstEpoch is the number of the first epoch, whose validator set is the initial validator set
+	firstEpoch = 1
+	// votingPowerDecimals is the number of decimals of the normalized voting power, see VotingPower
+	votingPowerDecimals = 18
+
+	// fingerprintBytes is the number of the config hash bytes the fingerprint is derived from (8 base32 chars)
+	fingerprintBytes = 5
+
+	// NativeTokenModeMintable means that the native token is minted on the chain itself
+	NativeTokenModeMintable = "mintable"
+	// NativeTokenModeBridged means that the native token mirrors the rootchain native token through the bridge
+	NativeTokenModeBridged = "bridged"
+	// NativeTokenModeNonBridgedFixed means that the native token has a fixed supply and it is not bridged
+	NativeTokenModeNonBridgedFixed = "non-bridged-fixed"
+
+	// RewardSourceInflation means that validators are rewarded from the funded reward wallet (the default)
+	RewardSourceInflation = "inflation"
+	// RewardSourceFees means that validators are rewarded with their share of the prior epoch transaction fees
+	RewardSourceFees = "fees"
+	// RewardSourceHybrid means that validators are rewarded both from the reward wallet and the transaction fees
+	RewardSourceHybrid = "hybrid"
+
+	// RewardDistributionEqual means that the epoch reward is split equally among the validators (the default)
+	RewardDistributionEqual = "equal"
+	// RewardDistributionStakeWeighted means that the epoch reward is split proportionally to the validators stake
+	RewardDistributionStakeWeighted = "stake-weighted"
+	// RewardDistributionUptimeWeighted means that the epoch reward is split proportionally to the validators uptime
+	RewardDistributionUptimeWeighted = "uptime-weighted"
+
+	// RewardWalletAmountUnlimited is the JSON value of the unlimited (nil) reward wallet amount,
+	// see RewardsConfig.IsUnlimited
+	RewardWalletAmountUnlimited = "unlimited"
+
+	// MinBlockTime is the minimum allowed BlockTime
+	MinBlockTime = 100 * time.Millisecond
+	// MaxBlockTime is the maximum allowed BlockTime
+	MaxBlockTime = 60 * time.Second
+
+	// BridgeJSONRPCEndpointEnvVar is the environment variable which overrides the bridge JSON RPC endpoint
+	BridgeJSONRPCEndpointEnvVar = "POLYBFT_BRIDGE_JSONRPC"
+)
+
+var (
+	// ErrZeroSprintSize is returned when the sprint size, which is used as a divisor, is zero
+	ErrZeroSprintSize = errors.New("sprint size must be at least 1")
+
+	// ErrInitialTrieRootMismatch is returned when the declared initial trie root doesn't match the computed one
+	ErrInitialTrieRootMismatch = errors.New("initial trie root mismatch")
+
+	// ErrChainIDReserved is returned when the chain ID is already claimed by another network
+	ErrChainIDReserved = errors.New("chain ID is reserved")
+
+	// ErrAmbiguousBridgeEndpointOverride is returned when BridgeJSONRPCEndpointEnvVar is set,
+	// but there are multiple bridges configured, so it is unclear which rootchain the endpoint belongs to
+	ErrAmbiguousBridgeEndpointOverride = errors.New("bridge JSON RPC endpoint override is ambiguous")
+
+	// ErrUnsupportedRootchain is returned when the bridge served by the node is not an EVM rootchain.
+	// The node runs the bridge managers against the rootchain contracts, hence the custom rootchain
+	// bridges are only carried by the config for the external adapters.
+	ErrUnsupportedRootchain = errors.New("bridge served by the node must be an EVM rootchain")
+)
 
 // PolyBFTConfig is the configuration file for the Polybft consensus protocol.
+// The read only methods (e.g. Validate, Warnings, Lint and Hash) are safe for concurrent use, as long as
+// the config is not mutated meanwhile. ValidatorByAddress and SetValidatorStake are not, since they maintain
+// the cached validator index (and the latter mutates the validator set), so the caller must serialize them
+// with any other use of the config.
 type PolyBFTConfig struct {
+	// ConfigVersion is the version of the config shape, see MigratePolyBFTConfig
+	ConfigVersion uint `json:"configVersion"`
+
+	// MinNodeVersion is the optional minimum (semantic) version of the node which is able to run the config
+	MinNodeVersion string `json:"minNodeVersion,omitempty"`
+
 	// InitialValidatorSet are the genesis validators
 	InitialValidatorSet []*validator.GenesisValidator `json:"initialValidatorSet"`
 
 	// Bridge is the rootchain bridge configuration
 	Bridge *BridgeConfig `json:"bridge"`
 
-	// EpochSize is size of epoch
+	// Bridges are the rootchain bridge configurations, keyed by the rootchain chain ID
+	Bridges map[uint64]*BridgeConfig `json:"bridges,omitempty"`
+
+	// EpochSize is size of epoch, expressed in blocks
 	EpochSize uint64 `json:"epochSize"`
 
 	// EpochReward is assigned to validators for blocks sealing
 	EpochReward uint64 `json:"epochReward"`
 
-	// SprintSize is size of sprint
+	// EpochRewardWei is arbitrary-precision amount assigned to validators for blocks sealing.
+	// When set, it takes precedence over EpochReward.
+	EpochRewardWei *big.Int `json:"-"`
+
+	// MaxEpochReward is the optional upper bound of the epoch reward, guarding against misconfigured emission
+	MaxEpochReward *big.Int `json:"-"`
+
+	// SprintSize is size of sprint, expressed in blocks. It must be at least 1.
 	SprintSize uint64 `json:"sprintSize"`
 
 	// BlockTime is target frequency of blocks production
 	BlockTime common.Duration `json:"blockTime"`
 
+	// BlockTimeDrift is the allowed deviation from the BlockTime (defaults to half of the BlockTime)
+	BlockTimeDrift common.Duration `json:"blockTimeDrift"`
+
+	// BlockTimeMax is the maximum time between two consecutive blocks (defaults to double of the BlockTime)
+	BlockTimeMax common.Duration `json:"blockTimeMax"`
+
 	// Governance is the initial governance address
 	Governance types.Address `json:"governance"`
 
 	// NativeTokenConfig defines name, symbol and decimal count of the native token
 	NativeTokenConfig *TokenConfig `json:"nativeTokenConfig"`
 
+	// InitialTrieRoot is the state root of the initial state the genesis is built upon.
+	// Zero hash means that there is no initial state, so the genesis state root is computed from scratch.
 	InitialTrieRoot types.Hash `json:"initialTrieRoot"`
 
 	// MaxValidatorSetSize indicates the maximum size of validator set
 	MaxValidatorSetSize uint64 `json:"maxValidatorSetSize"`
 
+	// ValidatorSetSizeSchedule optionally changes the maximum size of validator set over time.
+	// It overrides MaxValidatorSetSize from the first scheduled epoch onwards, see MaxValidatorSetSizeAt.
+	ValidatorSetSizeSchedule []SizeChange `json:"validatorSetSizeSchedule,omitempty"`
+
+	// ExcludedValidators are the addresses of the genesis validators (e.g. archive nodes),
+	// which are kept in the InitialValidatorSet, but are never part of the active validator set
+	ExcludedValidators []types.Address `json:"excludedValidators,omitempty"`
+
 	// RewardConfig defines rewards configuration
 	RewardConfig *RewardsConfig `json:"rewardConfig"`
+
+	// WithdrawalWaitPeriod is the number of epochs the unstaked funds are locked for, before the validator
+	// can withdraw them (see WithdrawalUnlockEpoch). Defaults to 0, meaning that the funds are withdrawable immediately.
+	WithdrawalWaitPeriod uint64 `json:"withdrawalWaitPeriod,omitempty"`
+
+	// MinFundedEpochs is the optional number of epochs the reward wallet is expected to fund,
+	// validation warns when the reward wallet amount covers fewer epochs (see EpochsFundedByRewardWallet)
+	MinFundedEpochs uint64 `json:"minFundedEpochs,omitempty"`
+
+	// PremineMints are the initial mints of the mintable native token
+	PremineMints []*TokenMint `json:"premineMints,omitempty"`
+
+	// SlashingConfig defines the penalties of the misbehaving validators, slashing is disabled when it is nil
+	SlashingConfig *SlashingConfig `json:"slashingConfig,omitempty"`
+
+	// BaseFeeConfig defines the EIP-1559 base fee market parameters, the legacy fixed fee is used when it is nil
+	BaseFeeConfig *BaseFeeConfig `json:"baseFeeConfig,omitempty"`
+
+	// FaucetConfig is the optional faucet account (e.g. of the test networks), premined at genesis
+	// separately from the validator and reward wallet allocations
+	FaucetConfig *FaucetConfig `json:"faucetConfig,omitempty"`
+
+	// AllowList are the addresses permitted to submit transactions, see IsSenderAllowed.
+	// The chain is permissionless when both AllowList and BlockList are empty.
+	AllowList []types.Address `json:"allowList,omitempty"`
+
+	// BlockList are the addresses not permitted to submit transactions, see IsSenderAllowed
+	BlockList []types.Address `json:"blockList,omitempty"`
+
+	// BlockGasLimit is the optional gas limit of the blocks
+	BlockGasLimit uint64 `json:"blockGasLimit,omitempty"`
+
+	// BlockGasTarget is the optional gas target of the blocks (defaults to half of the BlockGasLimit),
+	// see EffectiveGasTarget
+	BlockGasTarget uint64 `json:"blockGasTarget,omitempty"`
+
+	// GenesisContracts are the contracts (e.g. the custom system contracts) deployed at genesis
+	GenesisContracts []*GenesisContract `json:"genesisContracts,omitempty"`
+
+	// ParamSchedule optionally changes the consensus parameters over time, see ParamAt.
+	// The entries must be sorted by AtEpoch and only the schedulableParams can be scheduled.
+	ParamSchedule []ScheduledChange `json:"paramSchedule,omitempty"`
+
+	// MinValidatorStake is the optional minimum stake a validator needs to join the active validator set,
+	// see MeetsMinStake. There is no minimum when it is nil.
+	MinValidatorStake *big.Int `json:"-"`
+
+	// ChainID is the chain ID of the chain, populated by the loaders from the chain config.
+	// It is not a part of the consensus config encoding, hence it doesn't affect Hash.
+	ChainID int64 `json:"-"`
+
+	// validatorIndex maps addresses of the initial validators to their positions in the InitialValidatorSet.
+	// It is built lazily by ValidatorByAddress.
+	validatorIndex map[types.Address]int
+}
+
+// DefaultPolyBFTConfig returns PolyBFTConfig populated with the recommended defaults.
+// InitialValidatorSet is left empty and it is up to the caller to populate it.
+func DefaultPolyBFTConfig() *PolyBFTConfig {
+	return &PolyBFTConfig{
+		ConfigVersion: CurrentPolyBFTConfigVersion,
+		// epoch consists of 50 blocks, that is ten sprints
+		EpochSize: 50,
+		// sprint consists of 5 blocks
+		SprintSize: 5,
+		// blocks are produced every 2 seconds
+		BlockTime: common.Duration{Duration: 2 * time.Second},
+		// at most 100 validators can be part of the validator set
+		MaxValidatorSetSize: 100,
+		// bridge is disabled by default
+		Bridge: nil,
+	}
+}
+
+// MinimalValidPolyBFTConfig returns the smallest deterministic config which passes Validate:
+// the defaults with a single initial validator and a fixed supply native token, without the bridge and rewards.
+// It is meant for the unit tests which need a valid config without loading it from the file.
+func MinimalValidPolyBFTConfig() PolyBFTConfig {
+	oneToken := new(big.Int).Exp(big.NewInt(10), big.NewInt(maxTokenDecimals), nil)
+
+	config := *DefaultPolyBFTConfig()
+	config.InitialValidatorSet = []*validator.GenesisValidator{
+		{
+			Address: types.StringToAddress("0x1"),
+			Balance: new(big.Int).Set(oneToken),
+			Stake:   new(big.Int).Set(oneToken),
+		},
+	}
+	config.NativeTokenConfig = &TokenConfig{
+		Name:       "Mind",
+		Symbol:     "MIND",
+		Decimals:   maxTokenDecimals,
+		IsMintable: false,
+	}
+
+	return config
+}
+
+// polyBFTConfigAlias is used to (un)marshal PolyBFTConfig without recursing into its own (un)marshaling methods
+type polyBFTConfigAlias PolyBFTConfig
+
+type polyBFTConfigRaw struct {
+	*polyBFTConfigAlias
+	EpochRewardWei    *string `json:"epochRewardWei,omitempty"`
+	MaxEpochReward    *string `json:"maxEpochReward,omitempty"`
+	MinValidatorStake *string `json:"minValidatorStake,omitempty"`
+	// RewardConfig is decoded once the native token decimals are known, see RewardsConfig.fromRaw
+	RewardConfig *rewardsConfigRaw `json:"rewardConfig"`
+}
+
+func (p PolyBFTConfig) MarshalJSON() ([]byte, error) {
+	alias := polyBFTConfigAlias(p)
+	raw := &polyBFTConfigRaw{polyBFTConfigAlias: &alias}
+
+	if p.EpochRewardWei != nil {
+		raw.EpochRewardWei = types.EncodeBigInt(p.EpochRewardWei)
+	}
+
+	if p.MaxEpochReward != nil {
+		raw.MaxEpochReward = types.EncodeBigInt(p.MaxEpochReward)
+	}
+
+	if p.MinValidatorStake != nil {
+		raw.MinValidatorStake = types.EncodeBigInt(p.MinValidatorStake)
+	}
+
+	if p.RewardConfig != nil {
+		raw.RewardConfig = p.RewardConfig.toRaw()
+	}
+
+	return json.Marshal(raw)
+}
+
+func (p *PolyBFTConfig) UnmarshalJSON(data []byte) error {
+	var (
+		raw = &polyBFTConfigRaw{polyBFTConfigAlias: (*polyBFTConfigAlias)(p)}
+		err error
+	)
+
+	if err = json.Unmarshal(data, raw); err != nil {
+		return err
+	}
+
+	p.EpochRewardWei, err = types.ParseUint256orHex(raw.EpochRewardWei)
+	if err != nil {
+		return fmt.Errorf("epochRewardWei: %w", err)
+	}
+
+	p.MaxEpochReward, err = types.ParseUint256orHex(raw.MaxEpochReward)
+	if err != nil {
+		return fmt.Errorf("maxEpochReward: %w", err)
+	}
+
+	p.MinValidatorStake, err = types.ParseUint256orHex(raw.MinValidatorStake)
+	if err != nil {
+		return fmt.Errorf("minValidatorStake: %w", err)
+	}
+
+	if raw.RewardConfig != nil {
+		decimals := uint8(defaultNativeTokenDecimals)
+		if p.NativeTokenConfig != nil {
+			decimals = p.NativeTokenConfig.Decimals
+		}
+
+		p.RewardConfig = &RewardsConfig{}
+		if err = p.RewardConfig.fromRaw(raw.RewardConfig, decimals); err != nil {
+			return fmt.Errorf("rewardConfig: %w", err)
+		}
+	}
+
+	return nil
 }
 
 // LoadPolyBFTConfig loads chain config from provided path and unmarshals PolyBFTConfig
 func LoadPolyBFTConfig(chainConfigFile string) (PolyBFTConfig, int64, error) {
-	chainCfg, err := chain.ImportFromFile(chainConfigFile)
+	return LoadPolyBFTConfigWithLogger(chainConfigFile, hclog.NewNullLogger())
+}
+
+// LoadPolyBFTConfigWithLogger loads chain config from provided path and unmarshals PolyBFTConfig,
+// logging the progress of the loading stages
+func LoadPolyBFTConfigWithLogger(chainConfigFile string, logger hclog.Logger) (PolyBFTConfig, int64, error) {
+	logger.Debug("loading chain config", "path", chainConfigFile)
+
+	chainCfg, err := importChainConfig(chainConfigFile)
+	if err != nil {
+		return PolyBFTConfig{}, 0, fmt.Errorf("failed to import chain config from %s: %w", chainConfigFile, err)
+	}
+
+	logger.Debug("chain config loaded", "path", chainConfigFile, "chainID", chainCfg.Params.ChainID)
+
+	polybftConfig, err := GetPolyBFTConfig(chainCfg, WithLogger(logger))
+	if err != nil {
+		return PolyBFTConfig{}, 0, fmt.Errorf("failed to get polybft config from %s: %w", chainConfigFile, err)
+	}
+
+	logger.Info("polybft config loaded", "path", chainConfigFile, "chainID", chainCfg.Params.ChainID,
+		"validators", len(polybftConfig.InitialValidatorSet), "bridge", polybftConfig.IsBridgeEnabled())
+
+	return polybftConfig, chainCfg.Params.ChainID, nil
+}
+
+// LoadPolyBFTConfigFromReader decodes chain config from provided reader and unmarshals PolyBFTConfig
+func LoadPolyBFTConfigFromReader(r io.Reader) (PolyBFTConfig, int64, error) {
+	data, err := io.ReadAll(r)
 	if err != nil {
 		return PolyBFTConfig{}, 0, err
 	}
 
+	chainCfg, err := chain.ImportFromReader(bytes.NewReader(data))
+	if err != nil {
+		return PolyBFTConfig{}, 0, newConfigLoadError(ErrConfigMalformed, err)
+	}
+
 	polybftConfig, err := GetPolyBFTConfig(chainCfg)
 	if err != nil {
 		return PolyBFTConfig{}, 0, err
 	}
 
-	return polybftConfig, chainCfg.Params.ChainID, err
+	return polybftConfig, chainCfg.Params.ChainID, nil
+}
+
+// ConfigLoadOption customizes the way PolyBFTConfig is loaded from the chain config
+type ConfigLoadOption func(*configLoadOptions)
+
+type configLoadOptions struct {
+	validate         bool
+	withoutOverrides bool
+	logger           hclog.Logger
+}
+
+// WithValidation makes the loader run PolyBFTConfig.Validate on the deserialized config
+func WithValidation() ConfigLoadOption {
+	return func(o *configLoadOptions) {
+		o.validate = true
+	}
+}
+
+// WithoutLocalOverrides makes the loader skip the overrides of this node (e.g. BridgeJSONRPCEndpointEnvVar),
+// which is the case of the configs of the other nodes (see FetchPolyBFTConfig)
+func WithoutLocalOverrides() ConfigLoadOption {
+	return func(o *configLoadOptions) {
+		o.withoutOverrides = true
+	}
+}
+
+// WithLogger sets the logger used while loading the config
+func WithLogger(logger hclog.Logger) ConfigLoadOption {
+	return func(o *configLoadOptions) {
+		o.logger = logger
+	}
 }
 
 // GetPolyBFTConfig deserializes provided chain config and returns PolyBFTConfig
-func GetPolyBFTConfig(chainConfig *chain.Chain) (PolyBFTConfig, error) {
-	consensusConfigJSON, err := json.Marshal(chainConfig.Params.Engine[ConsensusName])
+func GetPolyBFTConfig(chainConfig *chain.Chain, opts ...ConfigLoadOption) (PolyBFTConfig, error) {
+	return GetPolyBFTConfigByName(chainConfig, ConsensusName, opts...)
+}
+
+// GetPolyBFTConfigByName deserializes provided chain config and returns PolyBFTConfig
+// stored under the given engine name (e.g. for the forks which renamed the consensus engine)
+func GetPolyBFTConfigByName(chainConfig *chain.Chain, engineName string,
+	opts ...ConfigLoadOption) (PolyBFTConfig, error) {
+	if chainConfig.Params == nil {
+		return PolyBFTConfig{}, newConfigLoadError(ErrConfigMalformed, errors.New("chain params are missing"))
+	}
+
+	options := &configLoadOptions{logger: hclog.Default().Named(engineName)}
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	consensusConfigJSON, err := json.Marshal(chainConfig.Params.Engine[engineName])
 	if err != nil {
-		return PolyBFTConfig{}, err
+		return PolyBFTConfig{}, newConfigLoadError(ErrConfigMalformed, err)
 	}
 
-	var polyBFTConfig PolyBFTConfig
-	if err = json.Unmarshal(consensusConfigJSON, &polyBFTConfig); err != nil {
-		return PolyBFTConfig{}, err
+	polyBFTConfig, err := MigratePolyBFTConfig(consensusConfigJSON)
+	if err != nil {
+		return PolyBFTConfig{}, newConfigLoadError(ErrConfigMalformed, err)
+	}
+
+	polyBFTConfig.ChainID = chainConfig.Params.ChainID
+
+	if !options.withoutOverrides {
+		if err := applyBridgeJSONRPCEndpointOverride(&polyBFTConfig, options.logger); err != nil {
+			return PolyBFTConfig{}, newConfigLoadError(ErrConfigInvalid, err)
+		}
+	}
+
+	if options.validate {
+		warnings, validationErr := SplitValidationWarnings(polyBFTConfig.Validate())
+		for _, warning := range warnings {
+			options.logger.Warn("polybft config validation", "warning", warning.Message)
+		}
+
+		if validationErr != nil {
+			return PolyBFTConfig{}, validationErr
+		}
 	}
 
 	return polyBFTConfig, nil
 }
 
-// BridgeConfig is the rootchain configuration, needed for bridging
-type BridgeConfig struct {
-	StateSenderAddr           types.Address `json:"stateSenderAddress"`
-	CheckpointManagerAddr     types.Address `json:"checkpointManagerAddress"`
-	ExitHelperAddr            types.Address `json:"exitHelperAddress"`
-	RootERC20PredicateAddr    types.Address `json:"erc20PredicateAddress"`
-	RootNativeERC20Addr       types.Address `json:"nativeERC20Address"`
-	RootERC721Addr            types.Address `json:"erc721Address"`
-	RootERC721PredicateAddr   types.Address `json:"erc721PredicateAddress"`
-	RootERC1155Addr           types.Address `json:"erc1155Address"`
-	RootERC1155PredicateAddr  types.Address `json:"erc1155PredicateAddress"`
-	CustomSupernetManagerAddr types.Address `json:"customSupernetManagerAddr"`
-	StakeManagerAddr          types.Address `json:"stakeManagerAddr"`
+// ToChainEngine validates the config and encodes it into the chain engine map (chain.Params.Engine),
+// in the same shape GetPolyBFTConfig reads it back. It allows assembling and checking the genesis in memory,
+// before it is written to the file. ChainID is not a part of the engine map and it is not encoded.
+func (p *PolyBFTConfig) ToChainEngine() (map[string]interface{}, error) {
+	if _, err := SplitValidationWarnings(p.Validate()); err != nil {
+		return nil, err
+	}
+
+	return p.encodeChainEngine()
+}
+
+// encodeChainEngine encodes the config into the chain engine map, without validating it
+func (p *PolyBFTConfig) encodeChainEngine() (map[string]interface{}, error) {
+	data, err := json.Marshal(p)
+	if err != nil {
+		return nil, err
+	}
+
+	// keep the numbers as they are encoded, so that the large integers don't lose precision
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var engineConfig map[string]interface{}
+	if err := decoder.Decode(&engineConfig); err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{ConsensusName: engineConfig}, nil
+}
+
+// ConfigWarning is an informational finding reported by PolyBFTConfig.Validate,
+// which on its own doesn't render the config invalid
+type ConfigWarning struct {
+	// Field is the JSON name of the field the warning is about, or empty string if there is no single one
+	Field   string
+	Message string
+}
+
+func (w *ConfigWarning) Error() string {
+	return "warning: " + w.Message
+}
+
+// FieldError is the validation error of a single config field. The error of the nested config
+// (e.g. the bridge) wraps the errors of its own fields, whose names are relative to the nested config.
+type FieldError struct {
+	// Field is the JSON name of the field, along with the index of the list (or map) entry if any,
+	// e.g. epochSize or genesisContracts[1]
+	Field string
+	Err   error
+}
+
+// fieldErrorf returns FieldError of the given field, formatting the error the same way as fmt.Errorf
+func fieldErrorf(field, format string, args ...interface{}) error {
+	return &FieldError{Field: field, Err: fmt.Errorf(format, args...)}
+}
 
-	JSONRPCEndpoint         string                   `json:"jsonRPCEndpoint"`
-	EventTrackerStartBlocks map[types.Address]uint64 `json:"eventTrackerStartBlocks"`
+func (e *FieldError) Error() string {
+	return e.Err.Error()
 }
 
-func (p *PolyBFTConfig) IsBridgeEnabled() bool {
-	return p.Bridge != nil
+func (e *FieldError) Unwrap() error {
+	return e.Err
 }
 
-// RootchainConfig contains rootchain metadata (such as JSON RPC endpoint and contract addresses)
-type RootchainConfig struct {
-	JSONRPCAddr string
+// Warnings returns the messages of the informational findings of Validate (e.g. likely misconfigurations),
+// leaving out the actual validation errors
+func (p *PolyBFTConfig) Warnings() []string {
+	warnings, _ := SplitValidationWarnings(p.Validate())
+
+	messages := make([]string, len(warnings))
+	for i, warning := range warnings {
+		messages[i] = warning.Message
+	}
 
-	StateSenderAddress           types.Address
-	CheckpointManagerAddress     types.Address
-	BLSAddress                   types.Address
-	BN256G2Address               types.Address
-	ExitHelperAddress            types.Address
-	RootERC20PredicateAddress    types.Address
-	RootNativeERC20Address       types.Address
-	ERC20TemplateAddress         types.Address
-	RootERC721PredicateAddress   types.Address
-	RootERC721Address            types.Address
-	RootERC721TemplateAddress    types.Address
-	RootERC1155PredicateAddress  types.Address
-	RootERC1155Address           types.Address
-	ERC1155TemplateAddress       types.Address
-	CustomSupernetManagerAddress types.Address
-	StakeManagerAddress          types.Address
+	return messages
 }
 
-// ToBridgeConfig creates BridgeConfig instance
-func (r *RootchainConfig) ToBridgeConfig() *BridgeConfig {
-	return &BridgeConfig{
-		JSONRPCEndpoint: r.JSONRPCAddr,
+// SplitValidationWarnings splits the error returned by PolyBFTConfig.Validate
+// into the actual validation errors (wrapping ErrConfigInvalid) and the informational warnings
+func SplitValidationWarnings(err error) ([]*ConfigWarning, error) {
+	if err == nil {
+		return nil, nil
+	}
+
+	var merr *multierror.Error
+	if !errors.As(err, &merr) {
+		var warning *ConfigWarning
+		if errors.As(err, &warning) {
+			return []*ConfigWarning{warning}, nil
+		}
+
+		if errors.Is(err, ErrConfigInvalid) {
+			return nil, err
+		}
 
-		StateSenderAddr:           r.StateSenderAddress,
-		CheckpointManagerAddr:     r.CheckpointManagerAddress,
-		ExitHelperAddr:            r.ExitHelperAddress,
-		RootERC20PredicateAddr:    r.RootERC20PredicateAddress,
-		RootNativeERC20Addr:       r.RootNativeERC20Address,
-		RootERC721Addr:            r.RootERC721Address,
-		RootERC721PredicateAddr:   r.RootERC721PredicateAddress,
-		RootERC1155Addr:           r.RootERC1155Address,
-		RootERC1155PredicateAddr:  r.RootERC1155PredicateAddress,
-		CustomSupernetManagerAddr: r.CustomSupernetManagerAddress,
-		StakeManagerAddr:          r.StakeManagerAddress,
+		return nil, newConfigLoadError(ErrConfigInvalid, err)
 	}
+
+	var (
+		validationErr error
+		warnings      []*ConfigWarning
+	)
+
+	for _, e := range merr.Errors {
+		var warning *ConfigWarning
+		if errors.As(e, &warning) {
+			warnings = append(warnings, warning)
+		} else {
+			validationErr = multierror.Append(validationErr, e)
+		}
+	}
+
+	if validationErr != nil {
+		return warnings, newConfigLoadError(ErrConfigInvalid, validationErr)
+	}
+
+	return warnings, nil
 }
 
-// TokenConfig is the configuration of native token used by edge network
-type TokenConfig struct {
-	Name       string `json:"name"`
-	Symbol     string `json:"symbol"`
-	Decimals   uint8  `json:"decimals"`
-	IsMintable bool   `json:"isMintable"`
+// IsGovernanceConfigured indicates whether governance address is set
+func (p *PolyBFTConfig) IsGovernanceConfigured() bool {
+	return p.Governance != types.ZeroAddress
 }
 
-type RewardsConfig struct {
-	// TokenAddress is the address of reward token on child chain
-	TokenAddress types.Address
+// Validate checks the invariants of the PolyBFTConfig and returns an error
+// which aggregates every violation found, wrapping ErrConfigInvalid.
+// Informational findings are reported as ConfigWarning (see SplitValidationWarnings),
+// the error which consists of the warnings only doesn't wrap ErrConfigInvalid.
+func (p *PolyBFTConfig) Validate() error {
+	var err error
+
+	if p.EpochSize == 0 {
+		err = multierror.Append(err, fieldErrorf("epochSize", "epochSize must be greater than 0 (epochSize=%d)", p.EpochSize))
+	}
+
+	if p.MinNodeVersion != "" {
+		if _, versionErr := parseSemanticVersion(p.MinNodeVersion); versionErr != nil {
+			err = multierror.Append(err, fieldErrorf("minNodeVersion", "minNodeVersion: %w", versionErr))
+		}
+	}
+
+	if p.SprintSize == 0 {
+		err = multierror.Append(err, fieldErrorf("sprintSize",
+			"sprintSize must be greater than 0 (sprintSize=%d)", p.SprintSize))
+	}
+
+	if p.EpochSize > 0 && p.SprintSize > 0 {
+		if p.SprintSize > p.EpochSize {
+			err = multierror.Append(err, fieldErrorf("sprintSize",
+				"sprintSize must not be greater than epochSize (sprintSize=%d, epochSize=%d)",
+				p.SprintSize, p.EpochSize))
+		} else if p.EpochSize%p.SprintSize != 0 {
+			err = multierror.Append(err, fieldErrorf("epochSize",
+				"epochSize must be divisible by sprintSize (epochSize=%d, sprintSize=%d)",
+				p.EpochSize, p.SprintSize))
+		}
+	}
+
+	if len(p.InitialValidatorSet) == 0 {
+		err = multierror.Append(err, fieldErrorf("initialValidatorSet", "initialValidatorSet must not be empty"))
+	}
+
+	if p.BlockTime.Duration <= 0 {
+		err = multierror.Append(err, fieldErrorf("blockTime",
+			"blockTime must be greater than 0 (blockTime=%s)", p.BlockTime.Duration))
+	} else if p.BlockTime.Duration < MinBlockTime || p.BlockTime.Duration > MaxBlockTime {
+		err = multierror.Append(err, fieldErrorf("blockTime", "blockTime must be between %s and %s (blockTime=%s)",
+			MinBlockTime, MaxBlockTime, p.BlockTime.Duration))
+	}
+
+	if p.BlockTimeDrift.Duration != 0 && (p.BlockTimeDrift.Duration < 0 || p.BlockTimeDrift.Duration >= p.BlockTime.Duration) {
+		err = multierror.Append(err, fieldErrorf("blockTimeDrift", "blockTimeDrift must be positive and less than blockTime "+
+			"(blockTimeDrift=%s, blockTime=%s)", p.BlockTimeDrift.Duration, p.BlockTime.Duration))
+	}
+
+	if p.BlockTimeMax.Duration != 0 && p.BlockTimeMax.Duration < p.BlockTime.Duration {
+		err = multierror.Append(err, fieldErrorf("blockTimeMax", "blockTimeMax must not be less than blockTime "+
+			"(blockTimeMax=%s, blockTime=%s)", p.BlockTimeMax.Duration, p.BlockTime.Duration))
+	}
+
+	if p.BlockGasTarget != 0 {
+		if p.BlockGasLimit == 0 {
+			err = multierror.Append(err, fieldErrorf("blockGasLimit", "blockGasLimit must be set when blockGasTarget is set "+
+				"(blockGasTarget=%d)", p.BlockGasTarget))
+		} else if p.BlockGasTarget > p.BlockGasLimit {
+			err = multierror.Append(err, fieldErrorf("blockGasTarget", "blockGasTarget must not be greater than blockGasLimit "+
+				"(blockGasTarget=%d, blockGasLimit=%d)", p.BlockGasTarget, p.BlockGasLimit))
+		}
+	}
+
+	if blsErr := p.ValidateBLSKeys(); blsErr != nil {
+		err = multierror.Append(err, blsErr)
+	}
+
+	for _, pair := range p.duplicateBLSKeyPairs() {
+		err = multierror.Append(err, fieldErrorf(fmt.Sprintf("initialValidatorSet[%d]", pair[1]),
+			"initial validators %s and %s have the same BLS public key",
+			p.InitialValidatorSet[pair[0]].Address, p.InitialValidatorSet[pair[1]].Address))
+	}
+
+	eligibleCount := uint64(len(p.ActiveValidators()))
+
+	if len(p.InitialValidatorSet) > 0 && eligibleCount == 0 {
+		err = multierror.Append(err, fieldErrorf("excludedValidators",
+			"all initial validators are excluded (excludedValidators=%v)", p.ExcludedValidators))
+	}
+
+	for i, change := range p.ValidatorSetSizeSchedule {
+		if change.Size == 0 {
+			err = multierror.Append(err, fieldErrorf(fmt.Sprintf("validatorSetSizeSchedule[%d]", i),
+				"validatorSetSizeSchedule[%d] size must be greater than 0 "+
+					"(fromEpoch=%d, size=%d)", i, change.FromEpoch, change.Size))
+		}
+
+		if i > 0 && change.FromEpoch <= p.ValidatorSetSizeSchedule[i-1].FromEpoch {
+			err = multierror.Append(err, fieldErrorf("validatorSetSizeSchedule",
+				"validatorSetSizeSchedule must be sorted by strictly increasing "+
+					"fromEpoch (validatorSetSizeSchedule[%d].fromEpoch=%d, validatorSetSizeSchedule[%d].fromEpoch=%d)",
+				i-1, p.ValidatorSetSizeSchedule[i-1].FromEpoch, i, change.FromEpoch))
+		}
+	}
+
+	for i, change := range p.ParamSchedule {
+		if parse, ok := schedulableParams[change.Field]; !ok {
+			err = multierror.Append(err, fieldErrorf(fmt.Sprintf("paramSchedule[%d]", i),
+				"paramSchedule[%d] field must be one of %s (field=%s)",
+				i, strings.Join(schedulableParamNames(), ", "), change.Field))
+		} else if parseErr := parse(change.Value); parseErr != nil {
+			err = multierror.Append(err, fieldErrorf(fmt.Sprintf("paramSchedule[%d]", i),
+				"paramSchedule[%d] value of %s is invalid (value=%s): %w",
+				i, change.Field, change.Value, parseErr))
+		}
+
+		if i == 0 {
+			continue
+		}
+
+		if prev := p.ParamSchedule[i-1]; change.AtEpoch < prev.AtEpoch {
+			err = multierror.Append(err, fieldErrorf("paramSchedule", "paramSchedule must be sorted by atEpoch "+
+				"(paramSchedule[%d].atEpoch=%d, paramSchedule[%d].atEpoch=%d)", i-1, prev.AtEpoch, i, change.AtEpoch))
+		}
+
+		for _, prev := range p.ParamSchedule[:i] {
+			if prev.AtEpoch == change.AtEpoch && prev.Field == change.Field {
+				err = multierror.Append(err, fieldErrorf("paramSchedule", "paramSchedule changes %s more than once at epoch %d",
+					change.Field, change.AtEpoch))
+
+				break
+			}
+		}
+	}
+
+	if p.MaxValidatorSetSize == 0 {
+		err = multierror.Append(err, fieldErrorf("maxValidatorSetSize",
+			"maxValidatorSetSize must be greater than 0 (maxValidatorSetSize=%d)",
+			p.MaxValidatorSetSize))
+	} else if dropped := eligibleCount - p.ActiveValidatorCount(); dropped > 0 {
+		err = multierror.Append(err, &ConfigWarning{
+			Field: "maxValidatorSetSize",
+			Message: fmt.Sprintf("maxValidatorSetSize is less than initial validator set size, %d validator(s) "+
+				"will be dropped from the active set (maxValidatorSetSize=%d, initialValidatorSet size=%d)",
+				dropped, p.MaxValidatorSetSizeAt(firstEpoch), eligibleCount),
+		})
+	}
+
+	for _, addr := range p.BlockList {
+		if containsAddress(p.AllowList, addr) {
+			err = multierror.Append(err, fieldErrorf("allowList",
+				"address %s is both in the allowList and the blockList", addr))
+		}
+	}
+
+	for _, addr := range p.ExcludedValidators {
+		if !p.isInitialValidator(addr) {
+			err = multierror.Append(err, &ConfigWarning{
+				Message: fmt.Sprintf("excluded validator %s is not part of the initial validator set", addr),
+			})
+		}
+	}
+
+	if p.MaxEpochReward != nil {
+		if p.MaxEpochReward.Sign() < 0 {
+			err = multierror.Append(err, fieldErrorf("maxEpochReward", "maxEpochReward must not be negative (maxEpochReward=%s)",
+				p.MaxEpochReward))
+		} else if epochReward := p.configuredEpochReward(); epochReward.Cmp(p.MaxEpochReward) > 0 {
+			err = multierror.Append(err, fieldErrorf("maxEpochReward", "epoch reward must not exceed maxEpochReward "+
+				"(epochReward=%s, maxEpochReward=%s)", epochReward, p.MaxEpochReward))
+		}
+	}
+
+	if p.MinValidatorStake != nil && p.MinValidatorStake.Sign() < 0 {
+		err = multierror.Append(err, fieldErrorf("minValidatorStake",
+			"minValidatorStake must not be negative (minValidatorStake=%s)",
+			p.MinValidatorStake))
+	}
+
+	for i, v := range p.InitialValidatorSet {
+		if v != nil && !p.MeetsMinStake(v.Stake) {
+			err = multierror.Append(err, fieldErrorf(fmt.Sprintf("initialValidatorSet[%d]", i),
+				"initial validator %s stake is below minValidatorStake (stake=%v, minValidatorStake=%s)",
+				v.Address, v.Stake, p.MinValidatorStake))
+		}
+	}
+
+	if p.RewardsEnabled() {
+		if !p.IsGovernanceConfigured() {
+			err = multierror.Append(err, fieldErrorf("governance",
+				"governance must not be zero address when rewards are enabled "+
+					"(governance=%s)", p.Governance))
+		}
+
+		if p.RewardConfig.RequiresFundedWallet() && p.RewardConfig.WalletAddress == types.ZeroAddress {
+			err = multierror.Append(err, fieldErrorf("rewardConfig.rewardWalletAddress",
+				"rewardWalletAddress must not be zero address when rewards are enabled "+
+					"(rewardWalletAddress=%s)", p.RewardConfig.WalletAddress))
+		}
+	}
+
+	if p.RewardsEnabled() && p.RewardConfig.RequiresFundedWallet() && p.RewardConfig.InflationRate == nil {
+		if fundedEpochs, fundedErr := p.EpochsFundedByRewardWallet(); fundedErr != nil {
+			err = multierror.Append(err, &FieldError{Field: "rewardConfig.rewardWalletAmount", Err: fundedErr})
+		} else if fundedEpochs < p.MinFundedEpochs {
+			err = multierror.Append(err, &ConfigWarning{
+				Message: fmt.Sprintf("reward wallet funds only %d epochs, which is less than minFundedEpochs (%d)",
+					fundedEpochs, p.MinFundedEpochs),
+			})
+		}
+	}
+
+	if p.RewardConfig != nil && p.RewardConfig.IsUnlimited() &&
+		(p.NativeTokenConfig == nil || !p.NativeTokenConfig.IsMintable) {
+		err = multierror.Append(err, fieldErrorf("rewardConfig.rewardWalletAmount",
+			"%s rewardWalletAmount is allowed only for mintable native token",
+			RewardWalletAmountUnlimited))
+	}
+
+	if p.RewardConfig != nil {
+		switch p.RewardConfig.RewardSource {
+		case "", RewardSourceInflation, RewardSourceFees, RewardSourceHybrid:
+		default:
+			err = multierror.Append(err, fieldErrorf("rewardConfig.rewardSource",
+				"rewardSource must be one of %s, %s or %s (rewardSource=%s)",
+				RewardSourceInflation, RewardSourceFees, RewardSourceHybrid, p.RewardConfig.RewardSource))
+		}
+
+		switch p.RewardConfig.RewardDistribution {
+		case "", RewardDistributionEqual, RewardDistributionStakeWeighted, RewardDistributionUptimeWeighted:
+		default:
+			err = multierror.Append(err, fieldErrorf("rewardConfig.rewardDistribution",
+				"rewardDistribution must be one of %s, %s or %s "+
+					"(rewardDistribution=%s)", RewardDistributionEqual, RewardDistributionStakeWeighted,
+				RewardDistributionUptimeWeighted, p.RewardConfig.RewardDistribution))
+		}
+	}
+
+	if p.RewardConfig != nil && p.RewardConfig.InflationRate != nil &&
+		(p.RewardConfig.InflationRate.Sign() < 0 || p.RewardConfig.InflationRate.Cmp(big.NewInt(maxBasisPoints)) > 0) {
+		err = multierror.Append(err, fieldErrorf("rewardConfig.rewardInflationRate",
+			"rewardInflationRate must be between 0 and %d basis points "+
+				"(rewardInflationRate=%s)", maxBasisPoints, p.RewardConfig.InflationRate))
+	}
+
+	if p.IsGovernanceConfigured() {
+		for _, v := range p.InitialValidatorSet {
+			if v != nil && v.Address == p.Governance {
+				err = multierror.Append(err, &ConfigWarning{
+					Field:   "governance",
+					Message: fmt.Sprintf("governance address %s is one of the initial validators", p.Governance),
+				})
+
+				break
+			}
+		}
+
+		if p.RewardConfig != nil && p.Governance == p.RewardConfig.WalletAddress {
+			err = multierror.Append(err, &ConfigWarning{
+				Field: "governance",
+				Message: fmt.Sprintf("governance address %s is the same as the reward wallet address, "+
+					"which allows governance to drain the reward wallet", p.Governance),
+			})
+		}
+
+		if p.RewardConfig != nil && p.Governance == p.RewardConfig.TokenAddress {
+			err = multierror.Append(err, &ConfigWarning{
+				Field:   "governance",
+				Message: fmt.Sprintf("governance address %s is the same as the reward token address", p.Governance),
+			})
+		}
+	}
+
+	if p.NativeTokenConfig != nil {
+		if tokenErr := p.NativeTokenConfig.Validate(); tokenErr != nil {
+			err = multierror.Append(err, fieldErrorf("nativeTokenConfig", "nativeTokenConfig: %w", tokenErr))
+		}
+	}
+
+	if p.SlashingConfig != nil {
+		if slashingErr := p.SlashingConfig.Validate(); slashingErr != nil {
+			err = multierror.Append(err, fieldErrorf("slashingConfig", "slashingConfig: %w", slashingErr))
+		}
+	}
+
+	if p.BaseFeeConfig != nil {
+		if baseFeeErr := p.BaseFeeConfig.Validate(); baseFeeErr != nil {
+			err = multierror.Append(err, fieldErrorf("baseFeeConfig", "baseFeeConfig: %w", baseFeeErr))
+		}
+	}
+
+	if p.FaucetConfig != nil {
+		if faucetErr := p.FaucetConfig.Validate(); faucetErr != nil {
+			err = multierror.Append(err, fieldErrorf("faucetConfig", "faucetConfig: %w", faucetErr))
+		}
+	}
+
+	genesisContracts := make(map[types.Address]struct{}, len(p.GenesisContracts))
 
-	// WalletAddress is the address of reward wallet on child chain
-	WalletAddress types.Address
+	for i, contract := range p.GenesisContracts {
+		if contract == nil {
+			err = multierror.Append(err, fieldErrorf(fmt.Sprintf("genesisContracts[%d]", i),
+				"genesisContracts[%d] must not be null", i))
 
-	// WalletAmount is the amount of tokens in reward wallet
-	WalletAmount *big.Int
+			continue
+		}
+
+		if contractErr := contract.Validate(); contractErr != nil {
+			err = multierror.Append(err, fieldErrorf(fmt.Sprintf("genesisContracts[%d]", i),
+				"genesisContracts[%d]: %w", i, contractErr))
+		}
+
+		if _, ok := genesisContracts[contract.Address]; ok && contract.Address != types.ZeroAddress {
+			err = multierror.Append(err, fieldErrorf(fmt.Sprintf("genesisContracts[%d]", i),
+				"genesis contract address %s is duplicated", contract.Address))
+		}
+
+		genesisContracts[contract.Address] = struct{}{}
+	}
+
+	if len(p.PremineMints) > 0 && (p.NativeTokenConfig == nil || p.NativeTokenConfig.IsFixedSupply()) {
+		err = multierror.Append(err, fieldErrorf("premineMints",
+			"premineMints are allowed only for mintable native token (premineMints=%d)",
+			len(p.PremineMints)))
+	}
+
+	if supplyErr := p.VerifySupplyInvariant(); supplyErr != nil {
+		err = multierror.Append(err, &FieldError{Field: "nativeTokenConfig", Err: supplyErr})
+	}
+
+	for i, mint := range p.PremineMints {
+		if mint == nil || mint.Amount == nil || mint.Amount.Sign() < 0 {
+			err = multierror.Append(err, fieldErrorf(fmt.Sprintf("premineMints[%d]", i),
+				"premineMints[%d]: amount must be non-negative (mint=%v)", i, mint))
+		}
+	}
+
+	// the mintable native token may have the rootchain native token as well, since it is the stake token
+	// on the rootchain (see rootchain deploy command), whereas the bridged one can't do without it
+	if rootNativeERC20Addr := p.rootNativeERC20Addr(); p.NativeTokenMode() == NativeTokenModeBridged &&
+		rootNativeERC20Addr == types.ZeroAddress {
+		err = multierror.Append(err, fieldErrorf("bridge.nativeERC20Address",
+			"bridged native token requires rootchain native token (nativeERC20Address=%s)", rootNativeERC20Addr))
+	}
+
+	if p.Bridge != nil {
+		err = appendBridgeValidationErrors(err, "bridge", p.Bridge.Validate())
+	}
+
+	// the node serves a single bridge, which has to be designated by the legacy Bridge if there are more of them
+	if p.Bridge == nil && len(p.Bridges) > 1 && p.PrimaryBridge() == nil {
+		err = multierror.Append(err, fieldErrorf("bridge", "bridge served by the node must be set "+
+			"when there are multiple bridges configured (bridges=%d)", len(p.Bridges)))
+	}
+
+	if servedErr := p.checkServedBridgeRootchain(); servedErr != nil {
+		err = multierror.Append(err, &FieldError{Field: "bridge", Err: servedErr})
+	}
+
+	for chainID, bridge := range p.Bridges {
+		if bridge == nil {
+			continue
+		}
+
+		err = appendBridgeValidationErrors(err, fmt.Sprintf("bridges[%d]", chainID), bridge.Validate())
+	}
+
+	return classifyValidationError(err)
 }
 
-func (r *RewardsConfig) MarshalJSON() ([]byte, error) {
-	raw := &rewardsConfigRaw{
-		TokenAddress:  r.TokenAddress,
-		WalletAddress: r.WalletAddress,
-		WalletAmount:  types.EncodeBigInt(r.WalletAmount),
+// appendBridgeValidationErrors appends the result of BridgeConfig.Validate of the given field to the aggregated
+// validation error. The errors are wrapped in the FieldError of the bridge, while the warnings are passed through
+// (with the field and the message prefixed by the bridge field), so that they don't render the config invalid.
+func appendBridgeValidationErrors(err error, field string, bridgeErr error) error {
+	warnings, bridgeErr := splitBridgeValidationWarnings(bridgeErr)
+
+	for _, warning := range warnings {
+		err = multierror.Append(err, &ConfigWarning{
+			Field:   field + "." + warning.Field,
+			Message: field + ": " + warning.Message,
+		})
 	}
 
-	return json.Marshal(raw)
+	if bridgeErr != nil {
+		err = multierror.Append(err, fieldErrorf(field, "%s: %w", field, bridgeErr))
+	}
+
+	return err
 }
 
-func (r *RewardsConfig) UnmarshalJSON(data []byte) error {
+// splitBridgeValidationWarnings splits the error returned by BridgeConfig.Validate into the warnings
+// and the aggregated errors, which are left unclassified, since they are nested in the config validation error
+func splitBridgeValidationWarnings(err error) ([]*ConfigWarning, error) {
+	var merr *multierror.Error
+	if !errors.As(err, &merr) {
+		return nil, err
+	}
+
 	var (
-		raw rewardsConfigRaw
-		err error
+		warnings  []*ConfigWarning
+		bridgeErr *multierror.Error
 	)
 
-	if err = json.Unmarshal(data, &raw); err != nil {
-		return err
+	for _, e := range merr.Errors {
+		var warning *ConfigWarning
+		if errors.As(e, &warning) {
+			warnings = append(warnings, warning)
+		} else {
+			bridgeErr = multierror.Append(bridgeErr, e)
+		}
 	}
 
-	r.TokenAddress = raw.TokenAddress
-	r.WalletAddress = raw.WalletAddress
+	return warnings, bridgeErr.ErrorOrNil()
+}
 
-	r.WalletAmount, err = types.ParseUint256orHex(raw.WalletAmount)
-	if err != nil {
-		return err
+// GetChainID returns the chain ID of the chain the config was loaded for
+func (p *PolyBFTConfig) GetChainID() int64 {
+	return p.ChainID
+}
+
+// BlockTimeSeconds returns the BlockTime in seconds
+func (p *PolyBFTConfig) BlockTimeSeconds() float64 {
+	return p.BlockTime.Duration.Seconds()
+}
+
+// EffectiveBlockTimeDrift returns the allowed block time drift, defaulting to half of the BlockTime when unset
+func (p *PolyBFTConfig) EffectiveBlockTimeDrift() common.Duration {
+	if p.BlockTimeDrift.Duration != 0 {
+		return p.BlockTimeDrift
+	}
+
+	return common.Duration{Duration: p.BlockTime.Duration / 2}
+}
+
+// EffectiveBlockTimeMax returns the maximum block time, defaulting to double of the BlockTime when unset
+func (p *PolyBFTConfig) EffectiveBlockTimeMax() common.Duration {
+	if p.BlockTimeMax.Duration != 0 {
+		return p.BlockTimeMax
+	}
+
+	return common.Duration{Duration: p.BlockTime.Duration * 2}
+}
+
+// EffectiveGasTarget returns the block gas target, defaulting to half of the BlockGasLimit when unset
+func (p *PolyBFTConfig) EffectiveGasTarget() uint64 {
+	if p.BlockGasTarget != 0 {
+		return p.BlockGasTarget
+	}
+
+	return p.BlockGasLimit / 2
+}
+
+// VerifySupplyInvariant checks that the declared total supply of the fixed supply native token
+// equals the sum of the premine mints, the reward wallet amount and the faucet amount.
+// The check is skipped for the mintable native token and when the total supply is not declared.
+func (p *PolyBFTConfig) VerifySupplyInvariant() error {
+	if p.NativeTokenConfig == nil || !p.NativeTokenConfig.IsFixedSupply() || p.NativeTokenConfig.TotalSupply == nil {
+		return nil
+	}
+
+	allocated := p.mintedAllocation()
+	if allocated.Cmp(p.NativeTokenConfig.TotalSupply) != 0 {
+		return fmt.Errorf("allocated native token supply doesn't match the declared total supply "+
+			"(premine mints, reward wallet and faucet amount=%s, totalSupply=%s)",
+			allocated, p.NativeTokenConfig.TotalSupply)
+	}
+
+	return nil
+}
+
+// TotalGenesisAllocation returns the total native token amount allocated at genesis, that is the sum of
+// the initial validators' stakes, the premine mints, the reward wallet amount and the faucet amount.
+// Missing amounts (including the unlimited reward wallet amount) are not counted.
+func (p *PolyBFTConfig) TotalGenesisAllocation() *big.Int {
+	total := p.mintedAllocation()
+
+	for _, v := range p.InitialValidatorSet {
+		if v != nil && v.Stake != nil {
+			total.Add(total, v.Stake)
+		}
+	}
+
+	return total
+}
+
+// mintedAllocation returns the sum of the premine mints, the reward wallet amount and the faucet amount
+func (p *PolyBFTConfig) mintedAllocation() *big.Int {
+	allocated := big.NewInt(0)
+
+	for _, mint := range p.PremineMints {
+		if mint != nil && mint.Amount != nil {
+			allocated.Add(allocated, mint.Amount)
+		}
+	}
+
+	if p.RewardConfig != nil && !p.RewardConfig.IsUnlimited() {
+		allocated.Add(allocated, p.RewardConfig.WalletAmount)
+	}
+
+	if p.FaucetConfig != nil && p.FaucetConfig.Amount != nil {
+		allocated.Add(allocated, p.FaucetConfig.Amount)
+	}
+
+	return allocated
+}
+
+// IsSenderAllowed checks whether the given address may submit transactions, according to the AllowList
+// and the BlockList. Blocked addresses are never allowed, while a non-empty AllowList permits only its addresses.
+// Every address is allowed when both lists are empty, that is the chain is permissionless.
+func (p *PolyBFTConfig) IsSenderAllowed(addr types.Address) bool {
+	if containsAddress(p.BlockList, addr) {
+		return false
+	}
+
+	return len(p.AllowList) == 0 || containsAddress(p.AllowList, addr)
+}
+
+// containsAddress checks whether the given address is in the list
+func containsAddress(addrs []types.Address, addr types.Address) bool {
+	for _, a := range addrs {
+		if a == addr {
+			return true
+		}
+	}
+
+	return false
+}
+
+// VerifyTrieRoot checks that the declared InitialTrieRoot matches the computed trie root.
+// Zero InitialTrieRoot is not verified, since it means that the root is yet to be computed.
+func (p *PolyBFTConfig) VerifyTrieRoot(computed types.Hash) error {
+	if p.InitialTrieRoot == types.ZeroHash {
+		return nil
+	}
+
+	if p.InitialTrieRoot != computed {
+		return fmt.Errorf("%w: declared %s, computed %s", ErrInitialTrieRootMismatch, p.InitialTrieRoot, computed)
 	}
 
 	return nil
 }
 
-type rewardsConfigRaw struct {
-	TokenAddress  types.Address `json:"rewardTokenAddress"`
-	WalletAddress types.Address `json:"rewardWalletAddress"`
-	WalletAmount  *string       `json:"rewardWalletAmount"`
+// VerifyChainIDNotReserved checks that the chain ID of the config is not claimed by any of the reserved networks,
+// given as the chain ID to network name registry. It prevents the transaction replay across the networks.
+func (p *PolyBFTConfig) VerifyChainIDNotReserved(reserved map[int64]string) error {
+	if network, ok := reserved[p.ChainID]; ok {
+		return fmt.Errorf("%w: chain ID %d is already used by network %q", ErrChainIDReserved, p.ChainID, network)
+	}
+
+	return nil
+}
+
+// String implements fmt.Stringer interface
+func (p *PolyBFTConfig) String() string {
+	var sb strings.Builder
+
+	bridgeStatus := "disabled"
+	if p.IsBridgeEnabled() {
+		bridgeStatus = "enabled"
+	}
+
+	nativeTokenSymbol := ""
+	if p.NativeTokenConfig != nil {
+		nativeTokenSymbol = p.NativeTokenConfig.Symbol
+	}
+
+	fmt.Fprintf(&sb, "Epoch size=%d\n", p.EpochSize)
+	fmt.Fprintf(&sb, "Sprint size=%d\n", p.SprintSize)
+	fmt.Fprintf(&sb, "Block time=%s\n", p.BlockTime.Duration)
+	fmt.Fprintf(&sb, "Validators=%d (active %d, max %d)\n",
+		len(p.InitialValidatorSet), p.ActiveValidatorCount(), p.MaxValidatorSetSize)
+	fmt.Fprintf(&sb, "Bridge=%s\n", bridgeStatus)
+
+	if len(p.Bridges) > 0 {
+		chainIDs := make([]uint64, 0, len(p.Bridges))
+		for chainID := range p.Bridges {
+			chainIDs = append(chainIDs, chainID)
+		}
+
+		sort.Slice(chainIDs, func(i, j int) bool { return chainIDs[i] < chainIDs[j] })
+
+		fmt.Fprintf(&sb, "Bridge rootchains=%v\n", chainIDs)
+	}
+
+	fmt.Fprintf(&sb, "Native token=%s", nativeTokenSymbol)
+
+	if p.NativeTokenConfig != nil && p.NativeTokenConfig.LogoURI != "" {
+		fmt.Fprintf(&sb, "\nNative token logo URI=%s", p.NativeTokenConfig.LogoURI)
+	}
+
+	if p.NativeTokenConfig != nil && p.NativeTokenConfig.Description != "" {
+		fmt.Fprintf(&sb, "\nNative token description=%s", p.NativeTokenConfig.Description)
+	}
+
+	return sb.String()
+}
+
+// Redacted returns a copy of the config which is safe for public display. Bridge JSON RPC endpoints are stripped
+// of the user info and the query string (which commonly hold API keys), keeping the scheme, host and path intact.
+// Private keys of the genesis validators are omitted as well. The original config is not modified.
+func (p *PolyBFTConfig) Redacted() *PolyBFTConfig {
+	cp := p.Copy()
+
+	for _, v := range cp.InitialValidatorSet {
+		if v != nil {
+			v.BlsPrivateKey = nil
+		}
+	}
+
+	if cp.Bridge != nil {
+		cp.Bridge.redactEndpoints()
+	}
+
+	for _, bridge := range cp.Bridges {
+		if bridge != nil {
+			bridge.redactEndpoints()
+		}
+	}
+
+	return cp
+}
+
+// Copy returns a deep copy of the PolyBFTConfig
+func (p *PolyBFTConfig) Copy() *PolyBFTConfig {
+	cp := *p
+	cp.validatorIndex = nil
+	cp.EpochRewardWei = copyBigInt(p.EpochRewardWei)
+	cp.MaxEpochReward = copyBigInt(p.MaxEpochReward)
+	cp.MinValidatorStake = copyBigInt(p.MinValidatorStake)
+
+	if p.InitialValidatorSet != nil {
+		cp.InitialValidatorSet = make([]*validator.GenesisValidator, len(p.InitialValidatorSet))
+
+		for i, v := range p.InitialValidatorSet {
+			if v == nil {
+				continue
+			}
+
+			vCopy := *v
+			vCopy.Balance = copyBigInt(v.Balance)
+			vCopy.Stake = copyBigInt(v.Stake)
+			cp.InitialValidatorSet[i] = &vCopy
+		}
+	}
+
+	if p.Bridge != nil {
+		cp.Bridge = p.Bridge.Copy()
+	}
+
+	if p.Bridges != nil {
+		cp.Bridges = make(map[uint64]*BridgeConfig, len(p.Bridges))
+
+		for chainID, bridge := range p.Bridges {
+			if bridge == nil {
+				cp.Bridges[chainID] = nil
+
+				continue
+			}
+
+			cp.Bridges[chainID] = bridge.Copy()
+		}
+	}
+
+	if p.NativeTokenConfig != nil {
+		nativeTokenConfig := *p.NativeTokenConfig
+		nativeTokenConfig.TotalSupply = copyBigInt(p.NativeTokenConfig.TotalSupply)
+		cp.NativeTokenConfig = &nativeTokenConfig
+	}
+
+	if p.RewardConfig != nil {
+		cp.RewardConfig = p.RewardConfig.Copy()
+	}
+
+	if p.SlashingConfig != nil {
+		cp.SlashingConfig = p.SlashingConfig.Copy()
+	}
+
+	if p.BaseFeeConfig != nil {
+		cp.BaseFeeConfig = p.BaseFeeConfig.Copy()
+	}
+
+	if p.FaucetConfig != nil {
+		cp.FaucetConfig = p.FaucetConfig.Copy()
+	}
+
+	if p.GenesisContracts != nil {
+		cp.GenesisContracts = make([]*GenesisContract, len(p.GenesisContracts))
+		for i, contract := range p.GenesisContracts {
+			if contract != nil {
+				cp.GenesisContracts[i] = contract.Copy()
+			}
+		}
+	}
+
+	if p.ValidatorSetSizeSchedule != nil {
+		cp.ValidatorSetSizeSchedule = make([]SizeChange, len(p.ValidatorSetSizeSchedule))
+		copy(cp.ValidatorSetSizeSchedule, p.ValidatorSetSizeSchedule)
+	}
+
+	if p.ParamSchedule != nil {
+		cp.ParamSchedule = make([]ScheduledChange, len(p.ParamSchedule))
+		copy(cp.ParamSchedule, p.ParamSchedule)
+	}
+
+	if p.ExcludedValidators != nil {
+		cp.ExcludedValidators = make([]types.Address, len(p.ExcludedValidators))
+		copy(cp.ExcludedValidators, p.ExcludedValidators)
+	}
+
+	if p.AllowList != nil {
+		cp.AllowList = make([]types.Address, len(p.AllowList))
+		copy(cp.AllowList, p.AllowList)
+	}
+
+	if p.BlockList != nil {
+		cp.BlockList = make([]types.Address, len(p.BlockList))
+		copy(cp.BlockList, p.BlockList)
+	}
+
+	if p.PremineMints != nil {
+		cp.PremineMints = make([]*TokenMint, len(p.PremineMints))
+
+		for i, mint := range p.PremineMints {
+			if mint == nil {
+				continue
+			}
+
+			cp.PremineMints[i] = &TokenMint{Address: mint.Address, Amount: copyBigInt(mint.Amount)}
+		}
+	}
+
+	return &cp
+}
+
+// Merge returns a new PolyBFTConfig, where non-zero fields of the override config take precedence.
+// Nil fields of the override config are ignored, while slices and maps are replaced wholesale.
+// Bridge, NativeTokenConfig, SlashingConfig, BaseFeeConfig and FaucetConfig are replaced as a whole,
+// whereas RewardConfig is merged field by field (WalletAmount is overridden only if it is non-nil,
+// hence the override can't make the reward wallet amount unlimited).
+// Neither of the configs is modified.
+func (p *PolyBFTConfig) Merge(override *PolyBFTConfig) *PolyBFTConfig {
+	merged := p.Copy()
+	if override == nil {
+		return merged
+	}
+
+	o := override.Copy()
+
+	if o.InitialValidatorSet != nil {
+		merged.InitialValidatorSet = o.InitialValidatorSet
+	}
+
+	if o.ExcludedValidators != nil {
+		merged.ExcludedValidators = o.ExcludedValidators
+	}
+
+	if o.AllowList != nil {
+		merged.AllowList = o.AllowList
+	}
+
+	if o.BlockList != nil {
+		merged.BlockList = o.BlockList
+	}
+
+	if o.PremineMints != nil {
+		merged.PremineMints = o.PremineMints
+	}
+
+	if o.Bridge != nil {
+		merged.Bridge = o.Bridge
+	}
+
+	if o.Bridges != nil {
+		merged.Bridges = o.Bridges
+	}
+
+	if o.ConfigVersion != 0 {
+		merged.ConfigVersion = o.ConfigVersion
+	}
+
+	if o.MinNodeVersion != "" {
+		merged.MinNodeVersion = o.MinNodeVersion
+	}
+
+	if o.EpochSize != 0 {
+		merged.EpochSize = o.EpochSize
+	}
+
+	if o.EpochReward != 0 {
+		merged.EpochReward = o.EpochReward
+	}
+
+	if o.EpochRewardWei != nil {
+		merged.EpochRewardWei = o.EpochRewardWei
+	}
+
+	if o.MaxEpochReward != nil {
+		merged.MaxEpochReward = o.MaxEpochReward
+	}
+
+	if o.MinValidatorStake != nil {
+		merged.MinValidatorStake = o.MinValidatorStake
+	}
+
+	if o.SprintSize != 0 {
+		merged.SprintSize = o.SprintSize
+	}
+
+	if o.WithdrawalWaitPeriod != 0 {
+		merged.WithdrawalWaitPeriod = o.WithdrawalWaitPeriod
+	}
+
+	if o.MinFundedEpochs != 0 {
+		merged.MinFundedEpochs = o.MinFundedEpochs
+	}
+
+	if o.BlockTime.Duration != 0 {
+		merged.BlockTime = o.BlockTime
+	}
+
+	if o.BlockTimeDrift.Duration != 0 {
+		merged.BlockTimeDrift = o.BlockTimeDrift
+	}
+
+	if o.BlockTimeMax.Duration != 0 {
+		merged.BlockTimeMax = o.BlockTimeMax
+	}
+
+	if o.BlockGasLimit != 0 {
+		merged.BlockGasLimit = o.BlockGasLimit
+	}
+
+	if o.BlockGasTarget != 0 {
+		merged.BlockGasTarget = o.BlockGasTarget
+	}
+
+	if o.Governance != types.ZeroAddress {
+		merged.Governance = o.Governance
+	}
+
+	if o.NativeTokenConfig != nil {
+		merged.NativeTokenConfig = o.NativeTokenConfig
+	}
+
+	if o.SlashingConfig != nil {
+		merged.SlashingConfig = o.SlashingConfig
+	}
+
+	if o.BaseFeeConfig != nil {
+		merged.BaseFeeConfig = o.BaseFeeConfig
+	}
+
+	if o.FaucetConfig != nil {
+		merged.FaucetConfig = o.FaucetConfig
+	}
+
+	if o.GenesisContracts != nil {
+		merged.GenesisContracts = o.GenesisContracts
+	}
+
+	if o.InitialTrieRoot != types.ZeroHash {
+		merged.InitialTrieRoot = o.InitialTrieRoot
+	}
+
+	if o.MaxValidatorSetSize != 0 {
+		merged.MaxValidatorSetSize = o.MaxValidatorSetSize
+	}
+
+	if o.ValidatorSetSizeSchedule != nil {
+		merged.ValidatorSetSizeSchedule = o.ValidatorSetSizeSchedule
+	}
+
+	if o.ParamSchedule != nil {
+		merged.ParamSchedule = o.ParamSchedule
+	}
+
+	if o.ChainID != 0 {
+		merged.ChainID = o.ChainID
+	}
+
+	if o.RewardConfig != nil {
+		if merged.RewardConfig == nil {
+			merged.RewardConfig = o.RewardConfig
+		} else {
+			if o.RewardConfig.TokenAddress != types.ZeroAddress {
+				merged.RewardConfig.TokenAddress = o.RewardConfig.TokenAddress
+			}
+
+			if o.RewardConfig.WalletAddress != types.ZeroAddress {
+				merged.RewardConfig.WalletAddress = o.RewardConfig.WalletAddress
+			}
+
+			if o.RewardConfig.WalletAmount != nil {
+				merged.RewardConfig.WalletAmount = o.RewardConfig.WalletAmount
+			}
+
+			if o.RewardConfig.InflationRate != nil {
+				merged.RewardConfig.InflationRate = o.RewardConfig.InflationRate
+			}
+
+			if o.RewardConfig.RewardSource != "" {
+				merged.RewardConfig.RewardSource = o.RewardConfig.RewardSource
+			}
+
+			if o.RewardConfig.RewardDistribution != "" {
+				merged.RewardConfig.RewardDistribution = o.RewardConfig.RewardDistribution
+			}
+		}
+	}
+
+	return merged
+}
+
+// copyBigInt returns a fresh copy of the provided big.Int, or nil if it is nil
+func copyBigInt(v *big.Int) *big.Int {
+	if v == nil {
+		return nil
+	}
+
+	return new(big.Int).Set(v)
 }
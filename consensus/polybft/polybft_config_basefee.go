@@ -0,0 +1,99 @@
+package polybft
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/go-multierror"
+)
+
+// BaseFeeEnabled indicates whether the EIP-1559 base fee market is configured
+func (p *PolyBFTConfig) BaseFeeEnabled() bool {
+	return p.BaseFeeConfig != nil
+}
+
+// BaseFeeConfig defines the EIP-1559 base fee market parameters
+type BaseFeeConfig struct {
+	// BaseFeeChangeDenom bounds the amount the base fee can change between the blocks
+	BaseFeeChangeDenom uint64
+
+	// ElasticityMultiplier bounds the maximum gas limit of the block relative to its gas target
+	ElasticityMultiplier uint64
+
+	// InitialBaseFee is the optional base fee of the genesis block
+	InitialBaseFee *big.Int
+}
+
+type baseFeeConfigRaw struct {
+	BaseFeeChangeDenom   uint64  `json:"baseFeeChangeDenom"`
+	ElasticityMultiplier uint64  `json:"elasticityMultiplier"`
+	InitialBaseFee       *string `json:"initialBaseFee,omitempty"`
+}
+
+func (b *BaseFeeConfig) MarshalJSON() ([]byte, error) {
+	raw := &baseFeeConfigRaw{
+		BaseFeeChangeDenom:   b.BaseFeeChangeDenom,
+		ElasticityMultiplier: b.ElasticityMultiplier,
+	}
+
+	if b.InitialBaseFee != nil {
+		raw.InitialBaseFee = types.EncodeBigInt(b.InitialBaseFee)
+	}
+
+	return json.Marshal(raw)
+}
+
+func (b *BaseFeeConfig) UnmarshalJSON(data []byte) error {
+	var (
+		raw baseFeeConfigRaw
+		err error
+	)
+
+	if err = json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	b.BaseFeeChangeDenom = raw.BaseFeeChangeDenom
+	b.ElasticityMultiplier = raw.ElasticityMultiplier
+
+	b.InitialBaseFee, err = types.ParseUint256orHex(raw.InitialBaseFee)
+	if err != nil {
+		return fmt.Errorf("initialBaseFee: %w", err)
+	}
+
+	return nil
+}
+
+// Copy returns a deep copy of the BaseFeeConfig
+func (b *BaseFeeConfig) Copy() *BaseFeeConfig {
+	cp := *b
+	cp.InitialBaseFee = copyBigInt(b.InitialBaseFee)
+
+	return &cp
+}
+
+// Validate checks that the base fee change denominator and the elasticity multiplier are set
+// and that the initial base fee is not negative
+func (b *BaseFeeConfig) Validate() error {
+	var err error
+
+	if b.BaseFeeChangeDenom == 0 {
+		err = multierror.Append(err, fieldErrorf("baseFeeChangeDenom",
+			"baseFeeChangeDenom must be greater than 0 (baseFeeChangeDenom=%d)",
+			b.BaseFeeChangeDenom))
+	}
+
+	if b.ElasticityMultiplier == 0 {
+		err = multierror.Append(err, fieldErrorf("elasticityMultiplier", "elasticityMultiplier must be greater than 0 "+
+			"(elasticityMultiplier=%d)", b.ElasticityMultiplier))
+	}
+
+	if b.InitialBaseFee != nil && b.InitialBaseFee.Sign() < 0 {
+		err = multierror.Append(err, fieldErrorf("initialBaseFee", "initialBaseFee must not be negative (initialBaseFee=%s)",
+			b.InitialBaseFee))
+	}
+
+	return err
+}
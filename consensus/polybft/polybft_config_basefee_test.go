@@ -0,0 +1,40 @@
+package polybft
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPolyBFTConfig_BaseFee(t *testing.T) {
+	t.Parallel()
+
+	config := MinimalValidPolyBFTConfig()
+	require.False(t, config.BaseFeeEnabled())
+
+	config.BaseFeeConfig = &BaseFeeConfig{InitialBaseFee: big.NewInt(-1)}
+	require.True(t, config.BaseFeeEnabled())
+
+	err := config.Validate()
+	require.ErrorContains(t, err, "baseFeeChangeDenom must be greater than 0 (baseFeeChangeDenom=0)")
+	require.ErrorContains(t, err, "elasticityMultiplier must be greater than 0 (elasticityMultiplier=0)")
+	require.ErrorContains(t, err, "initialBaseFee must not be negative (initialBaseFee=-1)")
+
+	config.BaseFeeConfig = &BaseFeeConfig{
+		BaseFeeChangeDenom:   8,
+		ElasticityMultiplier: 2,
+		InitialBaseFee:       big.NewInt(1_000_000_000),
+	}
+	require.NoError(t, config.Validate())
+
+	data, err := json.Marshal(config)
+	require.NoError(t, err)
+	require.Contains(t, string(data), `"baseFeeConfig":{"baseFeeChangeDenom":8,"elasticityMultiplier":2,`+
+		`"initialBaseFee":"0x3b9aca00"}`)
+
+	var decoded PolyBFTConfig
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	require.True(t, config.Equal(&decoded))
+}
@@ -0,0 +1,778 @@
+package polybft
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/go-multierror"
+)
+
+// LoadBridgeConfig loads and validates the standalone bridge config (e.g. bridge.json) from the given path,
+// so that the rootchain addresses can be maintained separately from the genesis (see AttachBridge)
+func LoadBridgeConfig(path string) (*BridgeConfig, error) {
+	data, err := readConfigFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read bridge config %s: %w", path, err)
+	}
+
+	var bridge BridgeConfig
+	if err := json.Unmarshal(data, &bridge); err != nil {
+		return nil, fmt.Errorf("failed to decode bridge config %s: %w", path, newConfigLoadError(ErrConfigMalformed, err))
+	}
+
+	if _, err := SplitValidationWarnings(bridge.Validate()); err != nil {
+		return nil, fmt.Errorf("invalid bridge config %s: %w", path, err)
+	}
+
+	return &bridge, nil
+}
+
+// applyBridgeJSONRPCEndpointOverride replaces the JSON RPC endpoint of the configured bridge (either the legacy
+// Bridge or the single entry of Bridges) with the one provided through BridgeJSONRPCEndpointEnvVar environment
+// variable, if it is set. A single endpoint can't serve multiple rootchains, hence the override is rejected
+// with ErrAmbiguousBridgeEndpointOverride if there are more bridges configured.
+func applyBridgeJSONRPCEndpointOverride(config *PolyBFTConfig, logger hclog.Logger) error {
+	endpoint := os.Getenv(BridgeJSONRPCEndpointEnvVar)
+	if endpoint == "" {
+		return nil
+	}
+
+	var bridges []*BridgeConfig
+
+	if config.Bridge != nil {
+		bridges = append(bridges, config.Bridge)
+	}
+
+	for _, bridge := range config.Bridges {
+		if bridge != nil {
+			bridges = append(bridges, bridge)
+		}
+	}
+
+	switch len(bridges) {
+	case 0:
+		return nil
+	case 1:
+	default:
+		return fmt.Errorf("%w: %s is set, but there are %d bridges configured",
+			ErrAmbiguousBridgeEndpointOverride, BridgeJSONRPCEndpointEnvVar, len(bridges))
+	}
+
+	logger.Info("overriding bridge JSON RPC endpoint from environment variable",
+		"env", BridgeJSONRPCEndpointEnvVar, "old", bridges[0].JSONRPCEndpoint, "new", endpoint)
+
+	bridges[0].JSONRPCEndpoint = endpoint
+
+	return nil
+}
+
+// BridgeConfig is the rootchain configuration, needed for bridging
+type BridgeConfig struct {
+	StateSenderAddr           types.Address `json:"stateSenderAddress"`
+	CheckpointManagerAddr     types.Address `json:"checkpointManagerAddress"`
+	ExitHelperAddr            types.Address `json:"exitHelperAddress"`
+	RootERC20PredicateAddr    types.Address `json:"erc20PredicateAddress"`
+	RootNativeERC20Addr       types.Address `json:"nativeERC20Address"`
+	RootERC721Addr            types.Address `json:"erc721Address"`
+	RootERC721PredicateAddr   types.Address `json:"erc721PredicateAddress"`
+	RootERC1155Addr           types.Address `json:"erc1155Address"`
+	RootERC1155PredicateAddr  types.Address `json:"erc1155PredicateAddress"`
+	CustomSupernetManagerAddr types.Address `json:"customSupernetManagerAddr"`
+	StakeManagerAddr          types.Address `json:"stakeManagerAddr"`
+
+	// ProxyAdminAddr is the ProxyAdmin of the upgradeable rootchain contracts, which holds the upgrade authority.
+	// It is JSON encoded as proxyAdminAddress, which is omitted when the address is not set.
+	ProxyAdminAddr types.Address `json:"-"`
+
+	// JSONRPCEndpoint is the rootchain JSON RPC endpoint, or comma separated list of the endpoints
+	JSONRPCEndpoint string `json:"jsonRPCEndpoint"`
+	// JSONRPCEndpoints are the additional (fallback) rootchain JSON RPC endpoints
+	JSONRPCEndpoints        []string                 `json:"jsonRPCEndpoints,omitempty"`
+	EventTrackerStartBlocks map[types.Address]uint64 `json:"eventTrackerStartBlocks"`
+
+	// CheckpointInterval is the number of epochs between two checkpoint submissions (defaults to 1)
+	CheckpointInterval uint64 `json:"checkpointInterval"`
+	// MaxBridgeBatchSize is the maximum number of bridge (state sync) events per commitment (defaults to 10).
+	// Bigger batches increase the throughput at the cost of the rootchain gas per batch.
+	MaxBridgeBatchSize uint64 `json:"maxBridgeBatchSize"`
+
+	// RootchainKind is the kind of the rootchain, either RootchainKindEVM (default) or RootchainKindCustom.
+	// The rootchain contract addresses and the JSON RPC endpoints are relevant only for the EVM rootchain.
+	RootchainKind string `json:"rootchainKind,omitempty"`
+	// Extra are the settings of the custom (non-EVM) rootchain adapter
+	Extra map[string]string `json:"extra,omitempty"`
+
+	// ExplorerURL is the optional https URL of the rootchain block explorer, see TxLink.
+	// It is only the metadata for the tooling (e.g. the bridge dashboard), it doesn't affect the consensus.
+	ExplorerURL string `json:"explorerURL,omitempty"`
+
+	// PausedPredicates are the token standards (e.g. "erc20" or "erc721", matched case insensitively)
+	// whose predicates are paused, meaning that the relayer skips their events (see IsPredicatePaused)
+	PausedPredicates []string `json:"pausedPredicates,omitempty"`
+
+	// BridgeActivationBlock is the child chain block from which the bridge is active (see IsBridgeActiveAt).
+	// It allows to launch the chain before the rootchain contracts are deployed. Defaults to 0 (genesis).
+	BridgeActivationBlock uint64 `json:"bridgeActivationBlock,omitempty"`
+}
+
+const (
+	// RootchainKindEVM is the kind of the EVM compatible rootchain, bridged through the rootchain contracts
+	RootchainKindEVM = "evm"
+	// RootchainKindCustom is the kind of the non-EVM rootchain, bridged through the pluggable adapter
+	// configured by the BridgeConfig.Extra settings
+	RootchainKindCustom = "custom"
+)
+
+func (p *PolyBFTConfig) IsBridgeEnabled() bool {
+	return p.Bridge != nil || len(p.Bridges) > 0
+}
+
+// IsBridgeActiveAt checks whether the bridge is active at the given child chain block, that is whether
+// the bridge served by the node (see PrimaryBridge) has reached its BridgeActivationBlock.
+// It is false whenever there is no such bridge.
+func (p *PolyBFTConfig) IsBridgeActiveAt(block uint64) bool {
+	bridge := p.PrimaryBridge()
+
+	return bridge != nil && block >= bridge.BridgeActivationBlock
+}
+
+// AttachBridge sets the (legacy single) bridge config to a copy of the given one, replacing the current one.
+// Nil bridge config detaches the bridge. The bridge affects the validity of the config as a whole
+// (e.g. the native token mode), so the config should be validated after the bridge is attached.
+func (p *PolyBFTConfig) AttachBridge(b *BridgeConfig) {
+	if b == nil {
+		p.Bridge = nil
+
+		return
+	}
+
+	p.Bridge = b.Copy()
+}
+
+// BridgeForChain returns the bridge configuration for the rootchain with the given chain ID.
+// It falls back to the legacy single Bridge configuration if there is no such entry in Bridges.
+func (p *PolyBFTConfig) BridgeForChain(chainID uint64) *BridgeConfig {
+	if bridge, ok := p.Bridges[chainID]; ok && bridge != nil {
+		return bridge
+	}
+
+	return p.Bridge
+}
+
+// PrimaryBridge returns the bridge served by the node, that is the legacy single Bridge configuration,
+// or the only entry of Bridges if the legacy one is not set. It is nil if no bridge is configured,
+// or if there are multiple entries of Bridges and the legacy Bridge doesn't designate the served one
+// (which is rejected by Validate).
+func (p *PolyBFTConfig) PrimaryBridge() *BridgeConfig {
+	if p.Bridge != nil {
+		return p.Bridge
+	}
+
+	var primary *BridgeConfig
+
+	for _, bridge := range p.Bridges {
+		if bridge == nil {
+			continue
+		}
+
+		if primary != nil {
+			return nil
+		}
+
+		primary = bridge
+	}
+
+	return primary
+}
+
+// checkServedBridgeRootchain checks that the bridge served by the node (see PrimaryBridge), if any,
+// is an EVM rootchain, returning ErrUnsupportedRootchain otherwise
+func (p *PolyBFTConfig) checkServedBridgeRootchain() error {
+	if bridge := p.PrimaryBridge(); bridge != nil && !bridge.IsEVMRootchain() {
+		return fmt.Errorf("%w (rootchainKind=%s)", ErrUnsupportedRootchain, bridge.RootchainKind)
+	}
+
+	return nil
+}
+
+// String implements fmt.Stringer interface
+func (b *BridgeConfig) String() string {
+	return fmt.Sprintf("JSON RPC endpoint=%s; State sender=%s; Checkpoint manager=%s; Exit helper=%s; "+
+		"ERC20 predicate=%s; ERC721 predicate=%s; ERC1155 predicate=%s;",
+		strings.Join(b.Endpoints(), ","), b.StateSenderAddr, b.CheckpointManagerAddr, b.ExitHelperAddr,
+		b.RootERC20PredicateAddr, b.RootERC721PredicateAddr, b.RootERC1155PredicateAddr)
+}
+
+// Copy returns a deep copy of the BridgeConfig
+func (b *BridgeConfig) Copy() *BridgeConfig {
+	cp := *b
+
+	if b.JSONRPCEndpoints != nil {
+		cp.JSONRPCEndpoints = make([]string, len(b.JSONRPCEndpoints))
+		copy(cp.JSONRPCEndpoints, b.JSONRPCEndpoints)
+	}
+
+	if b.EventTrackerStartBlocks != nil {
+		cp.EventTrackerStartBlocks = make(map[types.Address]uint64, len(b.EventTrackerStartBlocks))
+		for addr, block := range b.EventTrackerStartBlocks {
+			cp.EventTrackerStartBlocks[addr] = block
+		}
+	}
+
+	if b.Extra != nil {
+		cp.Extra = make(map[string]string, len(b.Extra))
+		for key, value := range b.Extra {
+			cp.Extra[key] = value
+		}
+	}
+
+	if b.PausedPredicates != nil {
+		cp.PausedPredicates = make([]string, len(b.PausedPredicates))
+		copy(cp.PausedPredicates, b.PausedPredicates)
+	}
+
+	return &cp
+}
+
+// WithRewrittenAddresses returns a copy of the BridgeConfig with the rootchain contract addresses
+// (including the EventTrackerStartBlocks keys) replaced according to the given mapping.
+// Addresses which are not in the mapping are kept unchanged.
+// If a rewritten start block key collides with an existing one, the rewritten entry wins.
+func (b *BridgeConfig) WithRewrittenAddresses(mapping map[types.Address]types.Address) *BridgeConfig {
+	cp := b.Copy()
+
+	for _, addr := range []*types.Address{
+		&cp.StateSenderAddr, &cp.CheckpointManagerAddr, &cp.ExitHelperAddr,
+		&cp.RootERC20PredicateAddr, &cp.RootNativeERC20Addr,
+		&cp.RootERC721Addr, &cp.RootERC721PredicateAddr,
+		&cp.RootERC1155Addr, &cp.RootERC1155PredicateAddr,
+		&cp.CustomSupernetManagerAddr, &cp.StakeManagerAddr, &cp.ProxyAdminAddr,
+	} {
+		if rewritten, ok := mapping[*addr]; ok {
+			*addr = rewritten
+		}
+	}
+
+	if b.EventTrackerStartBlocks != nil {
+		cp.EventTrackerStartBlocks = make(map[types.Address]uint64, len(b.EventTrackerStartBlocks))
+
+		for addr, block := range b.EventTrackerStartBlocks {
+			if _, ok := mapping[addr]; !ok {
+				cp.EventTrackerStartBlocks[addr] = block
+			}
+		}
+
+		for addr, block := range b.EventTrackerStartBlocks {
+			if rewritten, ok := mapping[addr]; ok {
+				cp.EventTrackerStartBlocks[rewritten] = block
+			}
+		}
+	}
+
+	return cp
+}
+
+// MarshalJSON encodes BridgeConfig, emitting event tracker start blocks ordered by address,
+// so that the same config always produces the same output
+func (b BridgeConfig) MarshalJSON() ([]byte, error) {
+	type bridgeConfigAlias BridgeConfig
+
+	raw := &struct {
+		bridgeConfigAlias
+		EventTrackerStartBlocks sortedStartBlocks `json:"eventTrackerStartBlocks"`
+		ProxyAdminAddr          *types.Address    `json:"proxyAdminAddress,omitempty"`
+	}{
+		bridgeConfigAlias:       bridgeConfigAlias(b),
+		EventTrackerStartBlocks: sortedStartBlocks(b.EventTrackerStartBlocks),
+	}
+
+	if b.ProxyAdminAddr != types.ZeroAddress {
+		raw.ProxyAdminAddr = &b.ProxyAdminAddr
+	}
+
+	return json.Marshal(raw)
+}
+
+// UnmarshalJSON decodes BridgeConfig, defaulting CheckpointInterval and MaxBridgeBatchSize when they are omitted
+func (b *BridgeConfig) UnmarshalJSON(data []byte) error {
+	type bridgeConfigAlias BridgeConfig
+
+	raw := struct {
+		bridgeConfigAlias
+		ProxyAdminAddr types.Address `json:"proxyAdminAddress"`
+	}{
+		bridgeConfigAlias: bridgeConfigAlias{
+			CheckpointInterval: defaultCheckpointInterval,
+			MaxBridgeBatchSize: maxCommitmentSize,
+		},
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	*b = BridgeConfig(raw.bridgeConfigAlias)
+	b.ProxyAdminAddr = raw.ProxyAdminAddr
+
+	return nil
+}
+
+// sortedStartBlocks is a map of event tracker start blocks, which is JSON encoded ordered by address bytes
+type sortedStartBlocks map[types.Address]uint64
+
+func (s sortedStartBlocks) MarshalJSON() ([]byte, error) {
+	if s == nil {
+		return []byte("null"), nil
+	}
+
+	addrs := make([]types.Address, 0, len(s))
+	for addr := range s {
+		addrs = append(addrs, addr)
+	}
+
+	sort.Slice(addrs, func(i, j int) bool {
+		return bytes.Compare(addrs[i][:], addrs[j][:]) < 0
+	})
+
+	var buf bytes.Buffer
+
+	buf.WriteByte('{')
+
+	for i, addr := range addrs {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+
+		key, err := json.Marshal(addr)
+		if err != nil {
+			return nil, err
+		}
+
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.WriteString(strconv.FormatUint(s[addr], 10))
+	}
+
+	buf.WriteByte('}')
+
+	return buf.Bytes(), nil
+}
+
+// Validate checks that the mandatory rootchain addresses are set, along with their event tracker start blocks,
+// and that at least one of the JSON RPC endpoints is a valid URL with ws, wss, http or https scheme.
+// Token addresses are required only for the predicates which are configured.
+// The custom (non-EVM) rootchain requires the non-empty Extra adapter settings instead of the rootchain contracts.
+// Informational findings are reported as ConfigWarning, the same way as by PolyBFTConfig.Validate.
+func (b *BridgeConfig) Validate() error {
+	var err error
+
+	if b.ExplorerURL != "" {
+		if u, parseErr := url.Parse(b.ExplorerURL); parseErr != nil || u.Scheme != "https" || u.Host == "" {
+			err = multierror.Append(err, fieldErrorf("explorerURL", "explorerURL must be a valid https URL (explorerURL=%s)",
+				b.ExplorerURL))
+		}
+	}
+
+	if b.CheckpointInterval < 1 {
+		err = multierror.Append(err, fieldErrorf("checkpointInterval",
+			"checkpointInterval must be at least 1 (checkpointInterval=%d)",
+			b.CheckpointInterval))
+	}
+
+	if b.MaxBridgeBatchSize < 1 {
+		err = multierror.Append(err, fieldErrorf("maxBridgeBatchSize",
+			"maxBridgeBatchSize must be at least 1 (maxBridgeBatchSize=%d)",
+			b.MaxBridgeBatchSize))
+	}
+
+	switch b.RootchainKind {
+	case "", RootchainKindEVM:
+	case RootchainKindCustom:
+		if len(b.Extra) == 0 {
+			err = multierror.Append(err, fieldErrorf("extra",
+				"extra must not be empty for the %s rootchain", RootchainKindCustom))
+		}
+
+		return err
+	default:
+		return multierror.Append(err, fieldErrorf("rootchainKind", "rootchainKind must be either %s or %s (rootchainKind=%s)",
+			RootchainKindEVM, RootchainKindCustom, b.RootchainKind))
+	}
+
+	requireAddress := func(field string, addr types.Address) {
+		if addr == types.ZeroAddress {
+			err = multierror.Append(err, fieldErrorf(field, "%s must not be zero address", field))
+		}
+	}
+
+	requireAddress("stateSenderAddress", b.StateSenderAddr)
+	requireAddress("checkpointManagerAddress", b.CheckpointManagerAddr)
+	requireAddress("exitHelperAddress", b.ExitHelperAddr)
+
+	for _, addr := range b.mandatoryAddresses() {
+		if _, ok := b.EventTrackerStartBlocks[addr]; addr != types.ZeroAddress && !ok {
+			err = multierror.Append(err, fieldErrorf("eventTrackerStartBlocks",
+				"eventTrackerStartBlocks must contain the start block "+
+					"of the mandatory rootchain contract %s", addr))
+		}
+	}
+
+	if b.HasERC20() {
+		requireAddress("nativeERC20Address", b.RootNativeERC20Addr)
+	}
+
+	if b.HasERC721() {
+		requireAddress("erc721Address", b.RootERC721Addr)
+	}
+
+	if b.HasERC1155() {
+		requireAddress("erc1155Address", b.RootERC1155Addr)
+	}
+
+	// the predicates are upgradeable, yet the rootchain deploy command doesn't deploy them behind the ProxyAdmin,
+	// so the unknown upgrade authority is only reported
+	if (b.HasERC20() || b.HasERC721() || b.HasERC1155()) && b.ProxyAdminAddr == types.ZeroAddress {
+		err = multierror.Append(err, &ConfigWarning{
+			Field:   "proxyAdminAddress",
+			Message: "proxyAdminAddress is not set, hence the upgrade authority of the predicates can't be verified",
+		})
+	}
+
+	for i, standard := range b.PausedPredicates {
+		if !isTokenStandard(standard) {
+			err = multierror.Append(err, fieldErrorf("pausedPredicates", "pausedPredicates must contain only %s, %s or %s "+
+				"(pausedPredicates[%d]=%s)", TokenStandardERC20, TokenStandardERC721, TokenStandardERC1155, i, standard))
+		}
+	}
+
+	endpoints := b.Endpoints()
+	hasValidEndpoint := false
+
+	for _, endpoint := range endpoints {
+		if isValidJSONRPCEndpoint(endpoint) {
+			hasValidEndpoint = true
+
+			break
+		}
+	}
+
+	if !hasValidEndpoint {
+		err = multierror.Append(err, fieldErrorf("jsonRPCEndpoints",
+			"none of the JSON RPC endpoints is a valid ws, wss, http or https URL (jsonRPCEndpoints=%q)", endpoints))
+	}
+
+	return err
+}
+
+// Endpoints returns the rootchain JSON RPC endpoints, in the order of preference.
+// Endpoints listed in JSONRPCEndpoint (either single one or comma separated list) come first,
+// followed by JSONRPCEndpoints. Blank and duplicate entries are omitted.
+func (b *BridgeConfig) Endpoints() []string {
+	endpoints := make([]string, 0, len(b.JSONRPCEndpoints)+1)
+	seen := make(map[string]struct{}, cap(endpoints))
+
+	for _, endpoint := range append(strings.Split(b.JSONRPCEndpoint, ","), b.JSONRPCEndpoints...) {
+		endpoint = strings.TrimSpace(endpoint)
+		if endpoint == "" {
+			continue
+		}
+
+		if _, ok := seen[endpoint]; !ok {
+			seen[endpoint] = struct{}{}
+			endpoints = append(endpoints, endpoint)
+		}
+	}
+
+	return endpoints
+}
+
+// PrimaryEndpoint returns the preferred rootchain JSON RPC endpoint, or empty string if there is none
+func (b *BridgeConfig) PrimaryEndpoint() string {
+	if endpoints := b.Endpoints(); len(endpoints) > 0 {
+		return endpoints[0]
+	}
+
+	return ""
+}
+
+// isValidJSONRPCEndpoint checks whether the endpoint is a ws, wss, http or https URL with the host
+func isValidJSONRPCEndpoint(endpoint string) bool {
+	u, err := url.Parse(endpoint)
+	if err != nil || u.Host == "" {
+		return false
+	}
+
+	switch u.Scheme {
+	case "ws", "wss", "http", "https":
+		return true
+	default:
+		return false
+	}
+}
+
+// redactEndpoints strips the user info and the query string from the JSON RPC endpoints
+func (b *BridgeConfig) redactEndpoints() {
+	endpoints := strings.Split(b.JSONRPCEndpoint, ",")
+	for i, endpoint := range endpoints {
+		endpoints[i] = redactEndpoint(strings.TrimSpace(endpoint))
+	}
+
+	b.JSONRPCEndpoint = strings.Join(endpoints, ",")
+
+	for i, endpoint := range b.JSONRPCEndpoints {
+		b.JSONRPCEndpoints[i] = redactEndpoint(endpoint)
+	}
+}
+
+// redactEndpoint strips the user info, the query string and the fragment from the given URL.
+// Endpoints which can't be parsed are replaced as a whole, since they may still contain secrets.
+func redactEndpoint(endpoint string) string {
+	if endpoint == "" {
+		return endpoint
+	}
+
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return redactedEndpoint
+	}
+
+	u.User = nil
+	u.RawQuery = ""
+	u.ForceQuery = false
+	u.Fragment = ""
+	u.RawFragment = ""
+
+	return u.String()
+}
+
+// ShouldCheckpoint checks if the checkpoint should be submitted at the end of the given epoch,
+// that is if the epoch is a multiple of CheckpointInterval. Zero interval is treated as 1.
+// The epochs changing the validator set are checkpointed regardless of the interval, and the interval
+// doesn't affect the checkpoints submitted in the middle of the epoch (see checkpointManager).
+func (b *BridgeConfig) ShouldCheckpoint(epoch uint64) bool {
+	if b.CheckpointInterval <= 1 {
+		return true
+	}
+
+	return epoch%b.CheckpointInterval == 0
+}
+
+// EffectiveBatchSize returns the maximum number of bridge events per commitment.
+// Zero MaxBridgeBatchSize is treated as the default one.
+func (b *BridgeConfig) EffectiveBatchSize() uint64 {
+	if b.MaxBridgeBatchSize == 0 {
+		return maxCommitmentSize
+	}
+
+	return b.MaxBridgeBatchSize
+}
+
+// mandatoryAddresses returns the addresses of the rootchain contracts which must be configured for the bridge
+func (b *BridgeConfig) mandatoryAddresses() []types.Address {
+	return []types.Address{b.StateSenderAddr, b.CheckpointManagerAddr, b.ExitHelperAddr}
+}
+
+// StartBlockFor returns the rootchain block the event tracker starts tracking the given contract from.
+// If the start block is not configured, it logs a warning by the given logger and returns 0,
+// meaning that the whole rootchain is scanned.
+func (b *BridgeConfig) StartBlockFor(addr types.Address, logger hclog.Logger) uint64 {
+	block, ok := b.EventTrackerStartBlocks[addr]
+	if !ok {
+		logger.Warn("event tracker start block is not configured, "+
+			"tracking the contract from the genesis block", "contract", addr)
+	}
+
+	return block
+}
+
+// SetStartBlock sets the rootchain block the event tracker starts tracking the given contract from
+func (b *BridgeConfig) SetStartBlock(addr types.Address, block uint64) {
+	if b.EventTrackerStartBlocks == nil {
+		b.EventTrackerStartBlocks = map[types.Address]uint64{}
+	}
+
+	b.EventTrackerStartBlocks[addr] = block
+}
+
+// TxLink returns the link to the given rootchain transaction in the block explorer,
+// or empty string if ExplorerURL is not set
+func (b *BridgeConfig) TxLink(txHash types.Hash) string {
+	if b.ExplorerURL == "" {
+		return ""
+	}
+
+	return strings.TrimRight(b.ExplorerURL, "/") + "/tx/" + txHash.String()
+}
+
+// IsEVMRootchain indicates whether the rootchain is EVM compatible, which is the case unless RootchainKind is custom
+func (b *BridgeConfig) IsEVMRootchain() bool {
+	return b.RootchainKind == "" || b.RootchainKind == RootchainKindEVM
+}
+
+// HasERC20 indicates whether the ERC20 predicate is configured on the rootchain
+func (b *BridgeConfig) HasERC20() bool {
+	return b.RootERC20PredicateAddr != types.ZeroAddress
+}
+
+// HasERC721 indicates whether the ERC721 predicate is configured on the rootchain
+func (b *BridgeConfig) HasERC721() bool {
+	return b.RootERC721PredicateAddr != types.ZeroAddress
+}
+
+// HasERC1155 indicates whether the ERC1155 predicate is configured on the rootchain
+func (b *BridgeConfig) HasERC1155() bool {
+	return b.RootERC1155PredicateAddr != types.ZeroAddress
+}
+
+const (
+	// TokenStandardERC20 is the token standard of the ERC20 predicate
+	TokenStandardERC20 = "ERC20"
+	// TokenStandardERC721 is the token standard of the ERC721 predicate
+	TokenStandardERC721 = "ERC721"
+	// TokenStandardERC1155 is the token standard of the ERC1155 predicate
+	TokenStandardERC1155 = "ERC1155"
+)
+
+// PredicateInfo describes the rootchain predicate of a single token standard
+type PredicateInfo struct {
+	Standard      string
+	PredicateAddr types.Address
+	TokenAddr     types.Address
+}
+
+// Predicates returns the configured rootchain predicates in the ERC20, ERC721, ERC1155 order.
+// The predicates whose predicate or token address is not set are omitted.
+func (b *BridgeConfig) Predicates() []PredicateInfo {
+	all := []PredicateInfo{
+		{Standard: TokenStandardERC20, PredicateAddr: b.RootERC20PredicateAddr, TokenAddr: b.RootNativeERC20Addr},
+		{Standard: TokenStandardERC721, PredicateAddr: b.RootERC721PredicateAddr, TokenAddr: b.RootERC721Addr},
+		{Standard: TokenStandardERC1155, PredicateAddr: b.RootERC1155PredicateAddr, TokenAddr: b.RootERC1155Addr},
+	}
+
+	predicates := make([]PredicateInfo, 0, len(all))
+
+	for _, predicate := range all {
+		if predicate.PredicateAddr == types.ZeroAddress || predicate.TokenAddr == types.ZeroAddress {
+			continue
+		}
+
+		predicates = append(predicates, predicate)
+	}
+
+	return predicates
+}
+
+// IsPredicatePaused checks whether the predicate of the given token standard is paused.
+// The standard is matched case insensitively, so both "erc20" and TokenStandardERC20 are accepted.
+func (b *BridgeConfig) IsPredicatePaused(standard string) bool {
+	for _, paused := range b.PausedPredicates {
+		if strings.EqualFold(paused, standard) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isTokenStandard checks whether the given value is one of the TokenStandard* constants, ignoring the case
+func isTokenStandard(standard string) bool {
+	for _, known := range []string{TokenStandardERC20, TokenStandardERC721, TokenStandardERC1155} {
+		if strings.EqualFold(standard, known) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// RootchainConfig contains rootchain metadata (such as JSON RPC endpoint and contract addresses)
+type RootchainConfig struct {
+	JSONRPCAddr string
+
+	StateSenderAddress           types.Address
+	CheckpointManagerAddress     types.Address
+	BLSAddress                   types.Address
+	BN256G2Address               types.Address
+	ExitHelperAddress            types.Address
+	RootERC20PredicateAddress    types.Address
+	RootNativeERC20Address       types.Address
+	ERC20TemplateAddress         types.Address
+	RootERC721PredicateAddress   types.Address
+	RootERC721Address            types.Address
+	RootERC721TemplateAddress    types.Address
+	RootERC1155PredicateAddress  types.Address
+	RootERC1155Address           types.Address
+	ERC1155TemplateAddress       types.Address
+	CustomSupernetManagerAddress types.Address
+	StakeManagerAddress          types.Address
+	ProxyAdminAddress            types.Address
+}
+
+// AllAddresses returns every configured (non-zero) rootchain contract address, keyed by the config field name.
+// Fields are discovered via reflection, so newly added contract addresses are included automatically.
+func (r *RootchainConfig) AllAddresses() map[string]types.Address {
+	addresses := map[string]types.Address{}
+
+	value := reflect.ValueOf(r).Elem()
+	addressType := reflect.TypeOf(types.Address{})
+
+	for i := 0; i < value.NumField(); i++ {
+		field := value.Type().Field(i)
+		if field.Type != addressType {
+			continue
+		}
+
+		addr, ok := value.Field(i).Interface().(types.Address)
+		if !ok || addr == types.ZeroAddress {
+			continue
+		}
+
+		addresses[field.Name] = addr
+	}
+
+	return addresses
+}
+
+// ToBridgeConfig creates BridgeConfig instance
+func (r *RootchainConfig) ToBridgeConfig() *BridgeConfig {
+	return &BridgeConfig{
+		JSONRPCEndpoint: r.JSONRPCAddr,
+
+		StateSenderAddr:           r.StateSenderAddress,
+		CheckpointManagerAddr:     r.CheckpointManagerAddress,
+		ExitHelperAddr:            r.ExitHelperAddress,
+		RootERC20PredicateAddr:    r.RootERC20PredicateAddress,
+		RootNativeERC20Addr:       r.RootNativeERC20Address,
+		RootERC721Addr:            r.RootERC721Address,
+		RootERC721PredicateAddr:   r.RootERC721PredicateAddress,
+		RootERC1155Addr:           r.RootERC1155Address,
+		RootERC1155PredicateAddr:  r.RootERC1155PredicateAddress,
+		CustomSupernetManagerAddr: r.CustomSupernetManagerAddress,
+		StakeManagerAddr:          r.StakeManagerAddress,
+		ProxyAdminAddr:            r.ProxyAdminAddress,
+
+		CheckpointInterval: defaultCheckpointInterval,
+		MaxBridgeBatchSize: maxCommitmentSize,
+	}
+}
+
+// DefaultRootchainTemplates returns RootchainConfig populated with the template addresses the standard deployer
+// (rootchain deploy command) deploys from the given deployer account, whose nonce is the given one
+// at the start of the deployment. The standard deployer deploys the ERC20, ERC721 and ERC1155 templates first,
+// one after another, hence they are created at the subsequent nonces of the deployer.
+func DefaultRootchainTemplates(deployer types.Address, nonce uint64) RootchainConfig {
+	return RootchainConfig{
+		ERC20TemplateAddress:      crypto.CreateAddress(deployer, nonce),
+		RootERC721TemplateAddress: crypto.CreateAddress(deployer, nonce+1),
+		ERC1155TemplateAddress:    crypto.CreateAddress(deployer, nonce+2),
+	}
+}
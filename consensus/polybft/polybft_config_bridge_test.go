@@ -0,0 +1,710 @@
+package polybft
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBridgeConfig_HasPredicates(t *testing.T) {
+	t.Parallel()
+
+	bridge := &BridgeConfig{}
+	require.False(t, bridge.HasERC20())
+	require.False(t, bridge.HasERC721())
+	require.False(t, bridge.HasERC1155())
+
+	bridge.RootERC20PredicateAddr = types.StringToAddress("1")
+	bridge.RootERC1155PredicateAddr = types.StringToAddress("2")
+	require.True(t, bridge.HasERC20())
+	require.False(t, bridge.HasERC721())
+	require.True(t, bridge.HasERC1155())
+}
+
+func TestBridgeConfig_Predicates(t *testing.T) {
+	t.Parallel()
+
+	require.Empty(t, (&BridgeConfig{}).Predicates())
+
+	bridge := newTestBridgeConfig()
+	require.Equal(t, []PredicateInfo{
+		{Standard: TokenStandardERC20, PredicateAddr: bridge.RootERC20PredicateAddr, TokenAddr: bridge.RootNativeERC20Addr},
+		{Standard: TokenStandardERC721, PredicateAddr: bridge.RootERC721PredicateAddr, TokenAddr: bridge.RootERC721Addr},
+		{Standard: TokenStandardERC1155, PredicateAddr: bridge.RootERC1155PredicateAddr, TokenAddr: bridge.RootERC1155Addr},
+	}, bridge.Predicates())
+
+	// predicates with the zero predicate or token address are omitted
+	bridge.RootERC20PredicateAddr = types.ZeroAddress
+	bridge.RootERC1155Addr = types.ZeroAddress
+	require.Equal(t, []PredicateInfo{
+		{Standard: TokenStandardERC721, PredicateAddr: bridge.RootERC721PredicateAddr, TokenAddr: bridge.RootERC721Addr},
+	}, bridge.Predicates())
+}
+
+func TestBridgeConfig_PausedPredicates(t *testing.T) {
+	t.Parallel()
+
+	bridge := newTestBridgeConfig()
+	require.NoError(t, bridge.Validate())
+	require.False(t, bridge.IsPredicatePaused(TokenStandardERC20))
+
+	bridge.PausedPredicates = []string{"erc20", "ERC1155"}
+	require.NoError(t, bridge.Validate())
+	require.True(t, bridge.IsPredicatePaused(TokenStandardERC20))
+	require.True(t, bridge.IsPredicatePaused("erc1155"))
+	require.False(t, bridge.IsPredicatePaused(TokenStandardERC721))
+
+	// unknown standards are rejected
+	bridge.PausedPredicates = append(bridge.PausedPredicates, "erc777")
+	require.ErrorContains(t, bridge.Validate(), "pausedPredicates must contain only ERC20, ERC721 or ERC1155 "+
+		"(pausedPredicates[2]=erc777)")
+
+	// the copy doesn't share the paused predicates
+	cp := bridge.Copy()
+	require.True(t, cp.Equal(bridge))
+	cp.PausedPredicates[0] = "erc721"
+	require.Equal(t, "erc20", bridge.PausedPredicates[0])
+	require.False(t, cp.Equal(bridge))
+}
+
+func TestPolyBFTConfig_BridgeForChain(t *testing.T) {
+	t.Parallel()
+
+	legacyBridge := &BridgeConfig{JSONRPCEndpoint: "http://127.0.0.1:8545"}
+	rootchainBridge := &BridgeConfig{JSONRPCEndpoint: "http://127.0.0.1:9545"}
+
+	config := &PolyBFTConfig{}
+	require.False(t, config.IsBridgeEnabled())
+	require.Nil(t, config.BridgeForChain(1))
+
+	config.Bridges = map[uint64]*BridgeConfig{2: rootchainBridge}
+	require.True(t, config.IsBridgeEnabled())
+	require.Equal(t, rootchainBridge, config.BridgeForChain(2))
+	require.Nil(t, config.BridgeForChain(1))
+
+	config.Bridge = legacyBridge
+	require.Equal(t, rootchainBridge, config.BridgeForChain(2))
+	require.Equal(t, legacyBridge, config.BridgeForChain(1))
+}
+
+func TestPolyBFTConfig_PrimaryBridge(t *testing.T) {
+	t.Parallel()
+
+	legacyBridge, firstBridge, secondBridge := newTestBridgeConfig(), newTestBridgeConfig(), newTestBridgeConfig()
+
+	config := MinimalValidPolyBFTConfig()
+	require.Nil(t, config.PrimaryBridge())
+
+	// the only rootchain bridge is served without the legacy one
+	config.Bridges = map[uint64]*BridgeConfig{2: firstBridge, 3: nil}
+	require.Same(t, firstBridge, config.PrimaryBridge())
+	require.NoError(t, config.Validate())
+
+	// multiple rootchain bridges need the legacy one to designate the served bridge
+	config.Bridges[3] = secondBridge
+	require.Nil(t, config.PrimaryBridge())
+	require.ErrorContains(t, config.Validate(), "bridge served by the node must be set "+
+		"when there are multiple bridges configured (bridges=2)")
+
+	config.Bridge = legacyBridge
+	require.Same(t, legacyBridge, config.PrimaryBridge())
+	require.NoError(t, config.Validate())
+}
+
+func TestPolyBFTConfig_IsBridgeActiveAt(t *testing.T) {
+	t.Parallel()
+
+	config := &PolyBFTConfig{}
+	require.False(t, config.IsBridgeActiveAt(0))
+	require.False(t, config.IsBridgeActiveAt(100))
+
+	// the bridge is active from the genesis by default
+	config.Bridge = &BridgeConfig{JSONRPCEndpoint: "http://127.0.0.1:8545"}
+	require.True(t, config.IsBridgeActiveAt(0))
+
+	config.Bridge.BridgeActivationBlock = 50
+	require.True(t, config.IsBridgeEnabled())
+	require.False(t, config.IsBridgeActiveAt(0))
+	require.False(t, config.IsBridgeActiveAt(49))
+	require.True(t, config.IsBridgeActiveAt(50))
+	require.True(t, config.IsBridgeActiveAt(51))
+
+	// the other rootchain bridges don't activate the served one
+	config.Bridges = map[uint64]*BridgeConfig{2: {BridgeActivationBlock: 20}}
+	require.False(t, config.IsBridgeActiveAt(20))
+	require.False(t, config.IsBridgeActiveAt(49))
+	require.True(t, config.IsBridgeActiveAt(50))
+
+	// without the legacy bridge, the only rootchain bridge is served
+	bridges := &PolyBFTConfig{Bridges: config.Bridges}
+	require.False(t, bridges.IsBridgeActiveAt(19))
+	require.True(t, bridges.IsBridgeActiveAt(20))
+
+	// neither of multiple rootchain bridges is served without the legacy bridge
+	bridges.Bridges[3] = &BridgeConfig{}
+	require.False(t, bridges.IsBridgeActiveAt(20))
+
+	// the activation block survives the JSON round trip
+	raw, err := json.Marshal(config.Bridge)
+	require.NoError(t, err)
+
+	var decoded BridgeConfig
+	require.NoError(t, json.Unmarshal(raw, &decoded))
+	require.Equal(t, uint64(50), decoded.BridgeActivationBlock)
+	require.True(t, decoded.Equal(config.Bridge))
+}
+
+func TestBridgeConfig_Validate(t *testing.T) {
+	t.Parallel()
+
+	t.Run("valid bridge", func(t *testing.T) {
+		t.Parallel()
+
+		require.NoError(t, newTestBridgeConfig().Validate())
+	})
+
+	t.Run("missing mandatory addresses and invalid endpoint", func(t *testing.T) {
+		t.Parallel()
+
+		err := (&BridgeConfig{JSONRPCEndpoint: "127.0.0.1"}).Validate()
+		require.ErrorContains(t, err, "stateSenderAddress must not be zero address")
+		require.ErrorContains(t, err, "checkpointManagerAddress must not be zero address")
+		require.ErrorContains(t, err, "exitHelperAddress must not be zero address")
+		require.ErrorContains(t, err, "none of the JSON RPC endpoints is a valid ws, wss, http or https URL")
+	})
+
+	t.Run("endpoints", func(t *testing.T) {
+		t.Parallel()
+
+		bridge := newTestBridgeConfig()
+		bridge.JSONRPCEndpoint = "wss://rootchain.example.com, https://fallback1.example.com,"
+		bridge.JSONRPCEndpoints = []string{"https://fallback1.example.com", "https://fallback2.example.com"}
+
+		require.NoError(t, bridge.Validate())
+		require.Equal(t, "wss://rootchain.example.com", bridge.PrimaryEndpoint())
+		require.Equal(t, []string{
+			"wss://rootchain.example.com",
+			"https://fallback1.example.com",
+			"https://fallback2.example.com",
+		}, bridge.Endpoints())
+
+		// at least one of the endpoints must be valid
+		bridge.JSONRPCEndpoint = "ftp://rootchain.example.com"
+		bridge.JSONRPCEndpoints = []string{"127.0.0.1"}
+		require.ErrorContains(t, bridge.Validate(), "none of the JSON RPC endpoints is a valid")
+
+		bridge.JSONRPCEndpoints = append(bridge.JSONRPCEndpoints, "ws://127.0.0.1:8546")
+		require.NoError(t, bridge.Validate())
+
+		// legacy single endpoint
+		bridge = newTestBridgeConfig()
+		require.Equal(t, []string{"http://127.0.0.1:8545"}, bridge.Endpoints())
+		require.Equal(t, "http://127.0.0.1:8545", bridge.PrimaryEndpoint())
+
+		require.Empty(t, (&BridgeConfig{}).PrimaryEndpoint())
+	})
+
+	t.Run("configured predicate without token", func(t *testing.T) {
+		t.Parallel()
+
+		bridge := newTestBridgeConfig()
+		bridge.RootERC721Addr = types.ZeroAddress
+
+		err := bridge.Validate()
+		require.ErrorContains(t, err, "erc721Address must not be zero address")
+		require.NotContains(t, err.Error(), "nativeERC20Address")
+		require.NotContains(t, err.Error(), "erc1155Address")
+	})
+}
+
+func TestPolyBFTConfig_BridgeJSONRPCEndpointOverride(t *testing.T) {
+	chainConfig := &chain.Chain{
+		Params: &chain.Params{
+			Engine: map[string]interface{}{
+				ConsensusName: map[string]interface{}{
+					"bridge": map[string]interface{}{
+						"jsonRPCEndpoint": "http://127.0.0.1:8545",
+					},
+				},
+			},
+		},
+	}
+
+	t.Setenv(BridgeJSONRPCEndpointEnvVar, "")
+
+	config, err := GetPolyBFTConfig(chainConfig, WithLogger(hclog.NewNullLogger()))
+	require.NoError(t, err)
+	require.Equal(t, "http://127.0.0.1:8545", config.Bridge.JSONRPCEndpoint)
+
+	t.Setenv(BridgeJSONRPCEndpointEnvVar, "https://rootchain.example.com")
+
+	config, err = GetPolyBFTConfig(chainConfig, WithLogger(hclog.NewNullLogger()))
+	require.NoError(t, err)
+	require.Equal(t, "https://rootchain.example.com", config.Bridge.JSONRPCEndpoint)
+
+	engine := chainConfig.Params.Engine[ConsensusName].(map[string]interface{}) //nolint:forcetypeassert
+
+	// the only bridge is overridden also when it is configured in bridges
+	delete(engine, "bridge")
+	engine["bridges"] = map[string]interface{}{
+		"5": map[string]interface{}{"jsonRPCEndpoint": "http://127.0.0.1:8545"},
+	}
+
+	config, err = GetPolyBFTConfig(chainConfig, WithLogger(hclog.NewNullLogger()))
+	require.NoError(t, err)
+	require.Equal(t, "https://rootchain.example.com", config.BridgeForChain(5).JSONRPCEndpoint)
+
+	// the endpoint can't belong to multiple rootchains
+	engine["bridge"] = map[string]interface{}{"jsonRPCEndpoint": "http://127.0.0.1:8545"}
+
+	_, err = GetPolyBFTConfig(chainConfig, WithLogger(hclog.NewNullLogger()))
+	require.ErrorIs(t, err, ErrAmbiguousBridgeEndpointOverride)
+	require.ErrorIs(t, err, ErrConfigInvalid)
+
+	// the override doesn't apply to the configs of the other nodes
+	_, err = GetPolyBFTConfig(chainConfig, WithLogger(hclog.NewNullLogger()), WithoutLocalOverrides())
+	require.NoError(t, err)
+}
+
+func TestBridgeConfig_MarshalJSONDeterministic(t *testing.T) {
+	t.Parallel()
+
+	addrs := []types.Address{
+		types.StringToAddress("0xaB00000000000000000000000000000000000000"),
+		types.StringToAddress("0x0100000000000000000000000000000000000000"),
+		types.StringToAddress("0xAa00000000000000000000000000000000000000"),
+		types.StringToAddress("0x1000000000000000000000000000000000000000"),
+	}
+
+	startBlocks := make(map[types.Address]uint64, len(addrs))
+	for i, addr := range addrs {
+		startBlocks[addr] = uint64(i)
+	}
+
+	bridge := &BridgeConfig{JSONRPCEndpoint: "http://127.0.0.1:8545", EventTrackerStartBlocks: startBlocks}
+
+	first, err := json.Marshal(bridge)
+	require.NoError(t, err)
+
+	second, err := json.Marshal(bridge)
+	require.NoError(t, err)
+	require.Equal(t, first, second)
+
+	// addresses are ordered by their bytes
+	positions := make([]int, 0, len(addrs))
+	for _, i := range []int{1, 3, 2, 0} {
+		positions = append(positions, strings.Index(string(first), addrs[i].String()))
+	}
+
+	require.IsIncreasing(t, positions)
+
+	var decoded BridgeConfig
+
+	require.NoError(t, json.Unmarshal(first, &decoded))
+	require.Equal(t, startBlocks, decoded.EventTrackerStartBlocks)
+	require.Equal(t, bridge.JSONRPCEndpoint, decoded.JSONRPCEndpoint)
+}
+
+func TestRootchainConfig_AllAddresses(t *testing.T) {
+	t.Parallel()
+
+	config := &RootchainConfig{
+		JSONRPCAddr:              "http://127.0.0.1:8545",
+		StateSenderAddress:       types.StringToAddress("1"),
+		CheckpointManagerAddress: types.StringToAddress("2"),
+		RootERC1155Address:       types.StringToAddress("3"),
+	}
+
+	require.Equal(t, map[string]types.Address{
+		"StateSenderAddress":       types.StringToAddress("1"),
+		"CheckpointManagerAddress": types.StringToAddress("2"),
+		"RootERC1155Address":       types.StringToAddress("3"),
+	}, config.AllAddresses())
+
+	require.Empty(t, (&RootchainConfig{}).AllAddresses())
+}
+
+func TestDefaultRootchainTemplates(t *testing.T) {
+	t.Parallel()
+
+	deployer := types.StringToAddress("0x1FC1411A3Bd09E63d4A306FD1EB838bA457ddA13")
+
+	templates := DefaultRootchainTemplates(deployer, 5)
+	addresses := []types.Address{
+		templates.ERC20TemplateAddress,
+		templates.RootERC721TemplateAddress,
+		templates.ERC1155TemplateAddress,
+	}
+
+	seen := map[types.Address]struct{}{}
+
+	for _, addr := range addresses {
+		require.NotEqual(t, types.ZeroAddress, addr)
+		require.NotContains(t, seen, addr)
+
+		seen[addr] = struct{}{}
+	}
+
+	require.Len(t, templates.AllAddresses(), len(addresses))
+
+	// the templates are created at the subsequent nonces of the deployer
+	require.Equal(t, templates.RootERC721TemplateAddress, DefaultRootchainTemplates(deployer, 4).ERC1155TemplateAddress)
+	require.NotEqual(t, templates, DefaultRootchainTemplates(types.StringToAddress("1"), 5))
+}
+
+func TestBridgeConfig_StartBlocks(t *testing.T) {
+	t.Parallel()
+
+	stateSender := types.StringToAddress("1")
+	bridge := &BridgeConfig{
+		StateSenderAddr:       stateSender,
+		CheckpointManagerAddr: types.StringToAddress("2"),
+		ExitHelperAddr:        types.StringToAddress("3"),
+		JSONRPCEndpoint:       "http://127.0.0.1:8545",
+		CheckpointInterval:    1,
+		MaxBridgeBatchSize:    maxCommitmentSize,
+	}
+
+	// missing start blocks are reported for each mandatory contract
+	err := bridge.Validate()
+	for _, addr := range bridge.mandatoryAddresses() {
+		require.ErrorContains(t, err, "eventTrackerStartBlocks must contain the start block "+
+			"of the mandatory rootchain contract "+addr.String())
+	}
+
+	var output strings.Builder
+
+	logger := hclog.New(&hclog.LoggerOptions{Output: &output})
+	require.Equal(t, uint64(0), bridge.StartBlockFor(stateSender, logger))
+	require.Contains(t, output.String(), "event tracker start block is not configured")
+
+	bridge.SetStartBlock(stateSender, 100)
+	bridge.SetStartBlock(bridge.CheckpointManagerAddr, 0)
+	require.Equal(t, uint64(100), bridge.StartBlockFor(stateSender, hclog.NewNullLogger()))
+	require.ErrorContains(t, bridge.Validate(), bridge.ExitHelperAddr.String())
+
+	bridge.SetStartBlock(bridge.ExitHelperAddr, 50)
+	require.NoError(t, bridge.Validate())
+	require.Equal(t, uint64(50), bridge.StartBlockFor(bridge.ExitHelperAddr, hclog.NewNullLogger()))
+}
+
+func TestBridgeConfig_CheckpointInterval(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Defaults to 1 when omitted", func(t *testing.T) {
+		t.Parallel()
+
+		var bridge BridgeConfig
+		require.NoError(t, json.Unmarshal([]byte(`{"jsonRPCEndpoint":"http://127.0.0.1:8545"}`), &bridge))
+		require.Equal(t, uint64(defaultCheckpointInterval), bridge.CheckpointInterval)
+
+		require.NoError(t, json.Unmarshal([]byte(`{"checkpointInterval":4}`), &bridge))
+		require.Equal(t, uint64(4), bridge.CheckpointInterval)
+
+		require.Equal(t, uint64(defaultCheckpointInterval), (&RootchainConfig{}).ToBridgeConfig().CheckpointInterval)
+	})
+
+	t.Run("Validation", func(t *testing.T) {
+		t.Parallel()
+
+		bridge := &BridgeConfig{CheckpointInterval: 0}
+		require.ErrorContains(t, bridge.Validate(), "checkpointInterval must be at least 1 (checkpointInterval=0)")
+
+		bridge.CheckpointInterval = 1
+		require.NotContains(t, bridge.Validate().Error(), "checkpointInterval")
+	})
+
+	t.Run("ShouldCheckpoint", func(t *testing.T) {
+		t.Parallel()
+
+		bridge := &BridgeConfig{CheckpointInterval: 1}
+		for epoch := uint64(1); epoch <= 5; epoch++ {
+			require.True(t, bridge.ShouldCheckpoint(epoch))
+		}
+
+		bridge.CheckpointInterval = 3
+		require.False(t, bridge.ShouldCheckpoint(1))
+		require.False(t, bridge.ShouldCheckpoint(2))
+		require.True(t, bridge.ShouldCheckpoint(3))
+		require.False(t, bridge.ShouldCheckpoint(4))
+		require.True(t, bridge.ShouldCheckpoint(6))
+	})
+}
+
+func TestBridgeConfig_MaxBridgeBatchSize(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Defaults when omitted", func(t *testing.T) {
+		t.Parallel()
+
+		var bridge BridgeConfig
+		require.NoError(t, json.Unmarshal([]byte(`{"jsonRPCEndpoint":"http://127.0.0.1:8545"}`), &bridge))
+		require.Equal(t, uint64(maxCommitmentSize), bridge.MaxBridgeBatchSize)
+
+		require.NoError(t, json.Unmarshal([]byte(`{"maxBridgeBatchSize":50}`), &bridge))
+		require.Equal(t, uint64(50), bridge.MaxBridgeBatchSize)
+
+		require.Equal(t, uint64(maxCommitmentSize), (&RootchainConfig{}).ToBridgeConfig().MaxBridgeBatchSize)
+	})
+
+	t.Run("Validation", func(t *testing.T) {
+		t.Parallel()
+
+		bridge := &BridgeConfig{MaxBridgeBatchSize: 0}
+		require.ErrorContains(t, bridge.Validate(), "maxBridgeBatchSize must be at least 1 (maxBridgeBatchSize=0)")
+
+		bridge.MaxBridgeBatchSize = 1
+		require.NotContains(t, bridge.Validate().Error(), "maxBridgeBatchSize")
+	})
+
+	t.Run("EffectiveBatchSize", func(t *testing.T) {
+		t.Parallel()
+
+		bridge := &BridgeConfig{}
+		require.Equal(t, uint64(maxCommitmentSize), bridge.EffectiveBatchSize())
+
+		bridge.MaxBridgeBatchSize = 25
+		require.Equal(t, uint64(25), bridge.EffectiveBatchSize())
+	})
+}
+
+func TestPolyBFTConfig_LoadAndAttachBridge(t *testing.T) {
+	t.Parallel()
+
+	writeBridge := func(t *testing.T, bridge *BridgeConfig) string {
+		t.Helper()
+
+		data, err := json.Marshal(bridge)
+		require.NoError(t, err)
+
+		path := filepath.Join(t.TempDir(), "bridge.json")
+		require.NoError(t, os.WriteFile(path, data, 0600))
+
+		return path
+	}
+
+	bridge, err := LoadBridgeConfig(writeBridge(t, newTestBridgeConfig()))
+	require.NoError(t, err)
+	require.True(t, newTestBridgeConfig().Equal(bridge))
+
+	config := MinimalValidPolyBFTConfig()
+	config.AttachBridge(bridge)
+	require.True(t, config.IsBridgeEnabled())
+	require.NoError(t, config.Validate())
+
+	// the attached bridge is a copy
+	bridge.StateSenderAddr = types.ZeroAddress
+	require.Equal(t, types.StringToAddress("0x1001"), config.Bridge.StateSenderAddr)
+
+	// the bridge deployed by the rootchain deploy command has the rootchain native token,
+	// which doesn't conflict with the mintable native token
+	config.NativeTokenConfig.IsMintable = true
+	require.NoError(t, config.Validate())
+
+	config.AttachBridge(nil)
+	require.False(t, config.IsBridgeEnabled())
+
+	_, err = LoadBridgeConfig(writeBridge(t, &BridgeConfig{CheckpointInterval: 1}))
+	require.ErrorContains(t, err, "invalid bridge config")
+
+	_, err = LoadBridgeConfig(filepath.Join(t.TempDir(), "missing.json"))
+	require.ErrorContains(t, err, "failed to read bridge config")
+}
+
+func TestBridgeConfig_ProxyAdmin(t *testing.T) {
+	t.Parallel()
+
+	proxyAdmin := types.StringToAddress("0x100c")
+
+	bridge := newTestBridgeConfig()
+	require.NoError(t, bridge.Validate())
+
+	// unknown upgrade authority of the predicates is only reported
+	bridge.ProxyAdminAddr = types.ZeroAddress
+
+	warnings, err := SplitValidationWarnings(bridge.Validate())
+	require.NoError(t, err)
+	require.Len(t, warnings, 1)
+	require.Equal(t, "proxyAdminAddress", warnings[0].Field)
+
+	config := MinimalValidPolyBFTConfig()
+	config.Bridges = map[uint64]*BridgeConfig{5: bridge}
+
+	validationErr := config.Validate()
+	require.NotErrorIs(t, validationErr, ErrConfigInvalid)
+
+	warnings, err = SplitValidationWarnings(validationErr)
+	require.NoError(t, err)
+	require.Len(t, warnings, 1)
+	require.Equal(t, "bridges[5].proxyAdminAddress", warnings[0].Field)
+	require.Contains(t, warnings[0].Message, "bridges[5]: proxyAdminAddress is not set")
+
+	// the warning doesn't hide the bridge errors
+	bridge.CheckpointInterval = 0
+	warnings, err = SplitValidationWarnings(config.Validate())
+	require.ErrorIs(t, err, ErrConfigInvalid)
+	require.ErrorContains(t, err, "checkpointInterval must be at least 1")
+	require.Len(t, warnings, 1)
+
+	bridge.CheckpointInterval = 1
+
+	// without the predicates, there is nothing to report
+	bridge.RootERC20PredicateAddr = types.ZeroAddress
+	bridge.RootERC721PredicateAddr = types.ZeroAddress
+	bridge.RootERC1155PredicateAddr = types.ZeroAddress
+	require.NoError(t, bridge.Validate())
+	require.NoError(t, config.Validate())
+
+	// unset proxy admin is not encoded
+	data, err := json.Marshal(bridge)
+	require.NoError(t, err)
+	require.NotContains(t, string(data), "proxyAdminAddress")
+
+	bridge.ProxyAdminAddr = proxyAdmin
+
+	data, err = json.Marshal(bridge)
+	require.NoError(t, err)
+	require.Contains(t, string(data), `"proxyAdminAddress":"0x000000000000000000000000000000000000100C"`)
+
+	var decoded BridgeConfig
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	require.True(t, bridge.Equal(&decoded))
+	require.Equal(t, uint64(defaultCheckpointInterval), decoded.CheckpointInterval)
+
+	rootchain := &RootchainConfig{ProxyAdminAddress: proxyAdmin}
+	require.Equal(t, proxyAdmin, rootchain.ToBridgeConfig().ProxyAdminAddr)
+	require.Equal(t, proxyAdmin, rootchain.AllAddresses()["ProxyAdminAddress"])
+}
+
+func TestBridgeConfig_RootchainKind(t *testing.T) {
+	t.Parallel()
+
+	bridge := newTestBridgeConfig()
+	require.True(t, bridge.IsEVMRootchain())
+	require.NoError(t, bridge.Validate())
+
+	// unset kind is encoded the same way as before
+	data, err := json.Marshal(bridge)
+	require.NoError(t, err)
+	require.NotContains(t, string(data), "rootchainKind")
+	require.NotContains(t, string(data), "extra")
+
+	bridge.RootchainKind = RootchainKindEVM
+	require.True(t, bridge.IsEVMRootchain())
+	require.True(t, bridge.Equal(newTestBridgeConfig()))
+
+	bridge.StateSenderAddr = types.ZeroAddress
+	require.ErrorContains(t, bridge.Validate(), "stateSenderAddress must not be zero address")
+
+	// custom rootchain doesn't require the rootchain contracts, but the adapter settings
+	custom := &BridgeConfig{RootchainKind: RootchainKindCustom, CheckpointInterval: 1, MaxBridgeBatchSize: 1}
+	require.False(t, custom.IsEVMRootchain())
+	require.ErrorContains(t, custom.Validate(), "extra must not be empty for the custom rootchain")
+
+	custom.Extra = map[string]string{"network": "mainnet"}
+	require.NoError(t, custom.Validate())
+
+	// the checkpoint and batch settings apply to any rootchain
+	custom.CheckpointInterval = 0
+	require.ErrorContains(t, custom.Validate(), "checkpointInterval must be at least 1")
+
+	custom.CheckpointInterval = 1
+
+	// the node serves only the EVM rootchain, the custom one is left to the external adapter
+	config := MinimalValidPolyBFTConfig()
+	config.Bridges = map[uint64]*BridgeConfig{9: custom}
+	require.ErrorIs(t, config.Validate(), ErrUnsupportedRootchain)
+	require.ErrorIs(t, config.checkServedBridgeRootchain(), ErrUnsupportedRootchain)
+
+	config.Bridge = newTestBridgeConfig()
+	require.NoError(t, config.Validate())
+	require.NoError(t, config.checkServedBridgeRootchain())
+
+	data, err = json.Marshal(custom)
+	require.NoError(t, err)
+
+	var decoded BridgeConfig
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	require.True(t, custom.Equal(&decoded))
+
+	cp := custom.Copy()
+	cp.Extra["network"] = "testnet"
+	require.Equal(t, "mainnet", custom.Extra["network"])
+	require.False(t, custom.Equal(cp))
+	require.False(t, custom.Equal(newTestBridgeConfig()))
+
+	custom.RootchainKind = "cosmos"
+	require.ErrorContains(t, custom.Validate(), "rootchainKind must be either evm or custom (rootchainKind=cosmos)")
+}
+
+func TestBridgeConfig_ExplorerURL(t *testing.T) {
+	t.Parallel()
+
+	txHash := types.StringToHash("0xabc")
+
+	bridge := newTestBridgeConfig()
+	require.Empty(t, bridge.TxLink(txHash))
+
+	data, err := json.Marshal(bridge)
+	require.NoError(t, err)
+	require.NotContains(t, string(data), "explorerURL")
+
+	bridge.ExplorerURL = "https://sepolia.etherscan.io/"
+	require.NoError(t, bridge.Validate())
+	require.Equal(t, "https://sepolia.etherscan.io/tx/"+txHash.String(), bridge.TxLink(txHash))
+
+	var decoded BridgeConfig
+
+	data, err = json.Marshal(bridge)
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	require.True(t, bridge.Equal(&decoded))
+	require.False(t, bridge.Equal(newTestBridgeConfig()))
+
+	for _, invalid := range []string{"http://sepolia.etherscan.io", "https://", "etherscan.io", "https://%zz"} {
+		bridge.ExplorerURL = invalid
+		require.ErrorContains(t, bridge.Validate(), "explorerURL must be a valid https URL (explorerURL="+invalid+")")
+	}
+}
+
+func TestBridgeConfig_WithRewrittenAddresses(t *testing.T) {
+	t.Parallel()
+
+	bridge := newTestBridgeConfig()
+	bridge.SetStartBlock(bridge.StateSenderAddr, 100)
+
+	testnetStateSender := types.StringToAddress("0x2001")
+	testnetCheckpointManager := types.StringToAddress("0x2002")
+
+	rewritten := bridge.WithRewrittenAddresses(map[types.Address]types.Address{
+		bridge.StateSenderAddr:          testnetStateSender,
+		bridge.CheckpointManagerAddr:    testnetCheckpointManager,
+		types.StringToAddress("0x3000"): types.StringToAddress("0x3001"),
+	})
+
+	require.Equal(t, testnetStateSender, rewritten.StateSenderAddr)
+	require.Equal(t, testnetCheckpointManager, rewritten.CheckpointManagerAddr)
+	require.Equal(t, bridge.ExitHelperAddr, rewritten.ExitHelperAddr)
+	require.Equal(t, bridge.StakeManagerAddr, rewritten.StakeManagerAddr)
+	require.Equal(t, map[types.Address]uint64{
+		testnetStateSender:       100,
+		testnetCheckpointManager: 0,
+		bridge.ExitHelperAddr:    0,
+	}, rewritten.EventTrackerStartBlocks)
+	require.NoError(t, rewritten.Validate())
+
+	// the original config is left untouched
+	require.Equal(t, types.StringToAddress("0x1001"), bridge.StateSenderAddr)
+	require.Equal(t, uint64(100), bridge.EventTrackerStartBlocks[bridge.StateSenderAddr])
+	require.NotContains(t, bridge.EventTrackerStartBlocks, testnetStateSender)
+
+	// without the mapping the config is just cloned
+	require.True(t, bridge.Equal(bridge.WithRewrittenAddresses(nil)))
+}
@@ -0,0 +1,214 @@
+package polybft
+
+import (
+	"bytes"
+	"encoding/base32"
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// CanonicalJSON returns the canonical JSON encoding of the PolyBFTConfig, which is suitable for signing.
+// It follows RFC 8785 (JSON Canonicalization Scheme): object keys are sorted everywhere by their UTF-16 code units,
+// there is no insignificant whitespace, strings are minimally escaped and numbers are normalized.
+// Unlike RFC 8785, integers are encoded exactly instead of being converted to IEEE 754 doubles,
+// so that the large integer fields (e.g. epochSize) don't lose precision.
+func (p *PolyBFTConfig) CanonicalJSON() ([]byte, error) {
+	data, err := json.Marshal(p)
+	if err != nil {
+		return nil, err
+	}
+
+	return canonicalizeJSON(data)
+}
+
+// canonicalizeJSON re-encodes the given JSON document into its canonical form, see CanonicalJSON
+func canonicalizeJSON(data []byte) ([]byte, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	if err := writeCanonicalJSON(&buf, value); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// writeCanonicalJSON writes the canonical encoding of the value decoded by json.Decoder (using UseNumber)
+func writeCanonicalJSON(buf *bytes.Buffer, value interface{}) error {
+	switch v := value.(type) {
+	case nil:
+		buf.WriteString("null")
+	case bool:
+		buf.WriteString(strconv.FormatBool(v))
+	case json.Number:
+		number, err := canonicalNumber(v)
+		if err != nil {
+			return err
+		}
+
+		buf.WriteString(number)
+	case string:
+		writeCanonicalString(buf, v)
+	case []interface{}:
+		buf.WriteByte('[')
+
+		for i, item := range v {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+
+			if err := writeCanonicalJSON(buf, item); err != nil {
+				return err
+			}
+		}
+
+		buf.WriteByte(']')
+	case map[string]interface{}:
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+
+		sort.Slice(keys, func(i, j int) bool {
+			return compareUTF16(keys[i], keys[j]) < 0
+		})
+
+		buf.WriteByte('{')
+
+		for i, key := range keys {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+
+			writeCanonicalString(buf, key)
+			buf.WriteByte(':')
+
+			if err := writeCanonicalJSON(buf, v[key]); err != nil {
+				return err
+			}
+		}
+
+		buf.WriteByte('}')
+	default:
+		return fmt.Errorf("unexpected JSON value of type %T", value)
+	}
+
+	return nil
+}
+
+// canonicalNumber normalizes the number: integers are encoded in decimal, without the leading zeros,
+// fraction or exponent, while the other numbers are encoded the same way as ECMAScript Number.toString does
+func canonicalNumber(number json.Number) (string, error) {
+	if integer, ok := new(big.Int).SetString(number.String(), 10); ok {
+		return integer.String(), nil
+	}
+
+	f, err := number.Float64()
+	if err != nil {
+		return "", fmt.Errorf("invalid number %s: %w", number, err)
+	}
+
+	if f == 0 {
+		// negative zero is encoded as zero
+		return "0", nil
+	}
+
+	if abs := math.Abs(f); abs >= 1e-6 && abs < 1e21 {
+		return strconv.FormatFloat(f, 'f', -1, 64), nil
+	}
+
+	// ECMAScript doesn't pad the exponent (1e-7 instead of 1e-07)
+	mantissa, exponent, _ := strings.Cut(strconv.FormatFloat(f, 'e', -1, 64), "e")
+	sign, digits := exponent[:1], strings.TrimLeft(exponent[1:], "0")
+
+	return mantissa + "e" + sign + digits, nil
+}
+
+// writeCanonicalString writes the quoted string, escaping only the quotation mark, the reverse solidus
+// and the control characters (using the short escape sequences where available)
+func writeCanonicalString(buf *bytes.Buffer, s string) {
+	buf.WriteByte('"')
+
+	for _, r := range s {
+		switch r {
+		case '"':
+			buf.WriteString(`\"`)
+		case '\\':
+			buf.WriteString(`\\`)
+		case '\b':
+			buf.WriteString(`\b`)
+		case '\f':
+			buf.WriteString(`\f`)
+		case '\n':
+			buf.WriteString(`\n`)
+		case '\r':
+			buf.WriteString(`\r`)
+		case '\t':
+			buf.WriteString(`\t`)
+		default:
+			if r < 0x20 {
+				fmt.Fprintf(buf, `\u%04x`, r)
+			} else {
+				buf.WriteRune(r)
+			}
+		}
+	}
+
+	buf.WriteByte('"')
+}
+
+// compareUTF16 compares the strings by their UTF-16 code units, as required for sorting the object keys
+func compareUTF16(a, b string) int {
+	au, bu := utf16.Encode([]rune(a)), utf16.Encode([]rune(b))
+
+	for i := 0; i < len(au) && i < len(bu); i++ {
+		if au[i] != bu[i] {
+			if au[i] < bu[i] {
+				return -1
+			}
+
+			return 1
+		}
+	}
+
+	return len(au) - len(bu)
+}
+
+// Hash returns keccak hash of the canonical JSON encoding of the PolyBFTConfig (see CanonicalJSON).
+// Canonical encoding sorts object keys and encodes big integers as hex strings,
+// so semantically equal configs produce the same hash.
+func (p *PolyBFTConfig) Hash() (types.Hash, error) {
+	data, err := p.CanonicalJSON()
+	if err != nil {
+		return types.ZeroHash, err
+	}
+
+	return crypto.Keccak256Hash(data), nil
+}
+
+// Fingerprint returns the short human friendly code of the config, which is meant for the manual verification
+// (e.g. reading it over the phone). It is the base32 encoding of the first 40 bits of Hash, that is 8 characters
+// of the A-Z and 2-7 alphabet. Empty string is returned if the config can't be hashed.
+func (p *PolyBFTConfig) Fingerprint() string {
+	hash, err := p.Hash()
+	if err != nil {
+		return ""
+	}
+
+	return base32.StdEncoding.EncodeToString(hash[:fingerprintBytes])
+}
@@ -0,0 +1,150 @@
+package polybft
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPolyBFTConfig_CanonicalJSON(t *testing.T) {
+	t.Parallel()
+
+	const (
+		ordered = `{
+			"epochSize": 10,
+			"sprintSize": 5,
+			"blockTime": "2s",
+			"maxValidatorSetSize": 100,
+			"nativeTokenConfig": {"name": "Mind", "symbol": "MIND", "decimals": 18, "isMintable": false},
+			"initialValidatorSet": [{"address": "0x0000000000000000000000000000000000000001", "balance": "0x1", "stake": "0x1"}]
+		}`
+		reordered = `{
+			"initialValidatorSet": [{"stake": "0x1", "balance": "0x1", "address": "0x0000000000000000000000000000000000000001"}],
+			"nativeTokenConfig": {"isMintable": false, "decimals": 18, "symbol": "MIND", "name": "Mind"},
+			"maxValidatorSetSize": 100,
+			"blockTime": "2s",
+			"sprintSize": 5,
+			"epochSize": 10
+		}`
+	)
+
+	canonicalize := func(input string) []byte {
+		var config PolyBFTConfig
+		require.NoError(t, json.Unmarshal([]byte(input), &config))
+
+		data, err := config.CanonicalJSON()
+		require.NoError(t, err)
+
+		hash, err := config.Hash()
+		require.NoError(t, err)
+		require.Equal(t, crypto.Keccak256Hash(data), hash)
+
+		return data
+	}
+
+	canonical := canonicalize(ordered)
+	require.Equal(t, canonical, canonicalize(reordered))
+	require.NotContains(t, string(canonical), " ")
+
+	// canonical form is a fixed point
+	again, err := canonicalizeJSON(canonical)
+	require.NoError(t, err)
+	require.Equal(t, canonical, again)
+}
+
+func TestCanonicalizeJSON(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		input    string
+		expected string
+	}{
+		{`{"b": 1, "a": [true, null, "x"]}`, `{"a":[true,null,"x"],"b":1}`},
+		{`{"z": {"d": 1, "c": 2}, "y": []}`, `{"y":[],"z":{"c":2,"d":1}}`},
+		{`[-0, 1.50, 1e2, 0.0000001, 1e21, 18446744073709551615]`, `[0,1.5,100,1e-7,1e+21,18446744073709551615]`},
+		{`"<a & b>é\u0001\n"`, "\"<a & b>é\\u0001\\n\""},
+		// U+1F600 precedes U+FB33 in UTF-16 (unlike in UTF-8)
+		{`{"\ufb33": 1, "\ud83d\ude00": 2, "a": 3}`, "{\"a\":3,\"\U0001F600\":2,\"\uFB33\":1}"},
+	}
+
+	for _, c := range cases {
+		actual, err := canonicalizeJSON([]byte(c.input))
+		require.NoError(t, err, c.input)
+		require.Equal(t, c.expected, string(actual), c.input)
+	}
+}
+
+func TestPolyBFTConfig_Hash(t *testing.T) {
+	t.Parallel()
+
+	addrs := []types.Address{types.StringToAddress("1"), types.StringToAddress("2"), types.StringToAddress("3")}
+
+	createConfig := func(order []int) *PolyBFTConfig {
+		startBlocks := make(map[types.Address]uint64, len(order))
+		for _, i := range order {
+			startBlocks[addrs[i]] = uint64(i)
+		}
+
+		return &PolyBFTConfig{
+			EpochSize:  10,
+			SprintSize: 5,
+			Bridge:     &BridgeConfig{EventTrackerStartBlocks: startBlocks},
+			RewardConfig: &RewardsConfig{
+				WalletAmount: new(big.Int).SetBytes([]byte{0, 0, 1}),
+			},
+		}
+	}
+
+	first := createConfig([]int{0, 1, 2})
+	second := createConfig([]int{2, 0, 1})
+	second.RewardConfig.WalletAmount = big.NewInt(1)
+
+	firstHash, err := first.Hash()
+	require.NoError(t, err)
+	require.NotEqual(t, types.ZeroHash, firstHash)
+
+	secondHash, err := second.Hash()
+	require.NoError(t, err)
+	require.Equal(t, firstHash, secondHash)
+
+	second.EpochSize = 20
+
+	secondHash, err = second.Hash()
+	require.NoError(t, err)
+	require.NotEqual(t, firstHash, secondHash)
+}
+
+func TestPolyBFTConfig_ChainIDExcludedFromHash(t *testing.T) {
+	t.Parallel()
+
+	config := &PolyBFTConfig{EpochSize: 10, SprintSize: 5, ChainID: 100}
+
+	hash, err := config.Hash()
+	require.NoError(t, err)
+
+	config.ChainID = 200
+
+	otherHash, err := config.Hash()
+	require.NoError(t, err)
+	require.Equal(t, hash, otherHash)
+}
+
+func TestPolyBFTConfig_Fingerprint(t *testing.T) {
+	t.Parallel()
+
+	config := MinimalValidPolyBFTConfig()
+
+	fingerprint := config.Fingerprint()
+	require.Regexp(t, "^[A-Z2-7]{8}$", fingerprint)
+
+	// identical configs match
+	require.Equal(t, fingerprint, config.Copy().Fingerprint())
+
+	other := config.Copy()
+	other.EpochSize++
+	require.NotEqual(t, fingerprint, other.Fingerprint())
+}
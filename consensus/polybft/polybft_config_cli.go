@@ -0,0 +1,172 @@
+package polybft
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/validator"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// flags of the genesis command, the config is exported to (see ToCLIFlags)
+const (
+	cliConsensusFlag         = "consensus"
+	cliEpochSizeFlag         = "epoch-size"
+	cliSprintSizeFlag        = "sprint-size"
+	cliBlockTimeFlag         = "block-time"
+	cliEpochRewardFlag       = "epoch-reward"
+	cliMaxValidatorCountFlag = "max-validator-count"
+	cliBlockGasLimitFlag     = "block-gas-limit"
+	cliTrieRootFlag          = "trieroot"
+	cliNativeTokenFlag       = "native-token-config"
+	cliRewardWalletFlag      = "reward-wallet"
+	cliValidatorsFlag        = "validators"
+	cliPremineFlag           = "premine"
+	cliStakeFlag             = "stake"
+)
+
+// ToCLIFlags returns the genesis command flags (in the --flag=value form) which reproduce the config.
+// Big integers are formatted in decimal and durations the way time.ParseDuration accepts them.
+// The initial validators are passed by the validators flag only if all of them have both the P2P multi address
+// and the BLS public key, otherwise the genesis command is expected to read them from the validators secrets.
+// Error is returned if any of the settings can't be expressed by the genesis command flags
+// (e.g. the bridge, the maximum epoch reward or the access lists), as the flags wouldn't reproduce the config.
+func (p *PolyBFTConfig) ToCLIFlags() ([]string, error) {
+	if fields := p.cliUnsupportedFields(); len(fields) > 0 {
+		return nil, fmt.Errorf("config can't be expressed by the genesis command flags, "+
+			"as there are no flags for %s", strings.Join(fields, ", "))
+	}
+
+	epochReward := p.configuredEpochReward()
+
+	flags := []string{
+		cliFlag(cliConsensusFlag, ConsensusName),
+		cliFlag(cliEpochSizeFlag, strconv.FormatUint(p.EpochSize, 10)),
+		cliFlag(cliSprintSizeFlag, strconv.FormatUint(p.SprintSize, 10)),
+		cliFlag(cliBlockTimeFlag, p.BlockTime.Duration.String()),
+		cliFlag(cliEpochRewardFlag, epochReward.String()),
+	}
+
+	if p.MaxValidatorSetSize != 0 {
+		flags = append(flags, cliFlag(cliMaxValidatorCountFlag, strconv.FormatUint(p.MaxValidatorSetSize, 10)))
+	}
+
+	if p.BlockGasLimit != 0 {
+		flags = append(flags, cliFlag(cliBlockGasLimitFlag, strconv.FormatUint(p.BlockGasLimit, 10)))
+	}
+
+	if p.InitialTrieRoot != types.ZeroHash {
+		flags = append(flags, cliFlag(cliTrieRootFlag, p.InitialTrieRoot.String()))
+	}
+
+	if t := p.NativeTokenConfig; t != nil {
+		flags = append(flags, cliFlag(cliNativeTokenFlag,
+			fmt.Sprintf("%s:%s:%d:%t", t.Name, t.Symbol, t.Decimals, t.IsMintable)))
+	}
+
+	if r := p.RewardConfig; r != nil && !r.IsUnlimited() {
+		flags = append(flags, cliFlag(cliRewardWalletFlag, cliAmount(r.WalletAddress, r.WalletAmount)))
+	}
+
+	if cliValidatorsExpressible(p.InitialValidatorSet) {
+		for _, v := range p.InitialValidatorSet {
+			flags = append(flags, cliFlag(cliValidatorsFlag,
+				fmt.Sprintf("%s:%s:%s", v.MultiAddr, v.Address, v.BlsKey)))
+		}
+	}
+
+	for _, v := range p.InitialValidatorSet {
+		if v == nil {
+			continue
+		}
+
+		if v.Balance != nil {
+			flags = append(flags, cliFlag(cliPremineFlag, cliAmount(v.Address, v.Balance)))
+		}
+
+		if v.Stake != nil {
+			flags = append(flags, cliFlag(cliStakeFlag, cliAmount(v.Address, v.Stake)))
+		}
+	}
+
+	for _, mint := range p.PremineMints {
+		if mint != nil && mint.Amount != nil {
+			flags = append(flags, cliFlag(cliPremineFlag, cliAmount(mint.Address, mint.Amount)))
+		}
+	}
+
+	return flags, nil
+}
+
+// cliUnsupportedFields returns the JSON names of the settings, which differ from the ones produced by
+// the genesis command, but there is no genesis command flag to set them
+func (p *PolyBFTConfig) cliUnsupportedFields() []string {
+	var fields []string
+
+	unsupported := func(isSet bool, field string) {
+		if isSet {
+			fields = append(fields, field)
+		}
+	}
+
+	unsupported(p.MinNodeVersion != "", "minNodeVersion")
+	unsupported(p.Bridge != nil, "bridge")
+	unsupported(len(p.Bridges) > 0, "bridges")
+	unsupported(!p.configuredEpochReward().IsUint64(), "epochRewardWei")
+	unsupported(p.MaxEpochReward != nil, "maxEpochReward")
+	unsupported(p.BlockTimeDrift.Duration != 0, "blockTimeDrift")
+	unsupported(p.BlockTimeMax.Duration != 0, "blockTimeMax")
+	// the genesis command uses the first validator as the governance
+	unsupported(p.Governance != types.ZeroAddress &&
+		(len(p.InitialValidatorSet) == 0 || p.InitialValidatorSet[0] == nil ||
+			p.Governance != p.InitialValidatorSet[0].Address), "governance")
+	unsupported(len(p.ValidatorSetSizeSchedule) > 0, "validatorSetSizeSchedule")
+	unsupported(len(p.ExcludedValidators) > 0, "excludedValidators")
+	unsupported(p.WithdrawalWaitPeriod != 0, "withdrawalWaitPeriod")
+	unsupported(p.MinFundedEpochs != 0, "minFundedEpochs")
+	unsupported(p.SlashingConfig != nil, "slashingConfig")
+	unsupported(p.BaseFeeConfig != nil, "baseFeeConfig")
+	unsupported(p.FaucetConfig != nil, "faucetConfig")
+	unsupported(len(p.AllowList) > 0, "allowList")
+	unsupported(len(p.BlockList) > 0, "blockList")
+	unsupported(p.BlockGasTarget != 0, "blockGasTarget")
+	unsupported(len(p.GenesisContracts) > 0, "genesisContracts")
+	unsupported(len(p.ParamSchedule) > 0, "paramSchedule")
+	unsupported(p.MinValidatorStake != nil, "minValidatorStake")
+
+	if r := p.RewardConfig; r != nil {
+		unsupported(r.IsUnlimited(), "rewardConfig.rewardWalletAmount ("+RewardWalletAmountUnlimited+")")
+		unsupported(r.InflationRate != nil, "rewardConfig.rewardInflationRate")
+		unsupported(r.RewardSource != "", "rewardConfig.rewardSource")
+		unsupported(r.RewardDistribution != "", "rewardConfig.rewardDistribution")
+	}
+
+	return fields
+}
+
+// cliFlag formats the flag with its value
+func cliFlag(name, value string) string {
+	return "--" + name + "=" + value
+}
+
+// cliAmount formats the <address>:<amount> flag value, with the amount in decimal
+func cliAmount(addr types.Address, amount fmt.Stringer) string {
+	return fmt.Sprintf("%s:%s", addr, amount)
+}
+
+// cliValidatorsExpressible checks whether all the validators can be passed by the validators flag,
+// that is whether each of them has the P2P multi address and the BLS public key
+func cliValidatorsExpressible(validators []*validator.GenesisValidator) bool {
+	if len(validators) == 0 {
+		return false
+	}
+
+	for _, v := range validators {
+		if v == nil || v.MultiAddr == "" || v.BlsKey == "" {
+			return false
+		}
+	}
+
+	return true
+}
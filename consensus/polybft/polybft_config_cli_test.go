@@ -0,0 +1,162 @@
+package polybft
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/validator"
+	"github.com/0xPolygon/polygon-edge/helper/common"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/spf13/pflag"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPolyBFTConfig_ToCLIFlags(t *testing.T) {
+	t.Parallel()
+
+	validators := validator.NewTestValidators(t, 2).GetParamValidators()
+	for i, v := range validators {
+		v.MultiAddr = fmt.Sprintf("/ip4/127.0.0.1/tcp/%d/p2p/16Uiu2HAmPpcN1CUrQ3zSaGHTQcvLRfrEdKXrHTMyYo5fmNZVHvYa",
+			30301+i)
+		v.Stake = big.NewInt(int64(1000 * (i + 1)))
+	}
+
+	hugeAmount, ok := new(big.Int).SetString("1000000000000000000000000", 10)
+	require.True(t, ok)
+
+	config := &PolyBFTConfig{
+		InitialValidatorSet: validators,
+		EpochSize:           20,
+		SprintSize:          4,
+		BlockTime:           common.Duration{Duration: 1500 * time.Millisecond},
+		EpochReward:         7,
+		MaxValidatorSetSize: 50,
+		BlockGasLimit:       30_000_000,
+		InitialTrieRoot:     types.StringToHash("0x1234"),
+		NativeTokenConfig:   &TokenConfig{Name: "Mind", Symbol: "MIND", Decimals: 18, IsMintable: true},
+		RewardConfig: &RewardsConfig{
+			WalletAddress: types.StringToAddress("0xabc"),
+			WalletAmount:  hugeAmount,
+		},
+		PremineMints: []*TokenMint{{Address: types.StringToAddress("0xdef"), Amount: big.NewInt(5)}},
+	}
+
+	// the genesis command can't be imported (it depends on this package),
+	// hence its flags are mirrored with the same types and names
+	var (
+		consensus, trieRoot, nativeToken, rewardWallet    string
+		epochSize, sprintSize, epochReward, maxValidators uint64
+		blockGasLimit                                     uint64
+		blockTime                                         time.Duration
+		validatorsRaw, premines, stakes                   []string
+	)
+
+	fs := pflag.NewFlagSet("genesis", pflag.ContinueOnError)
+	fs.StringVar(&consensus, "consensus", "", "")
+	fs.Uint64Var(&epochSize, "epoch-size", 0, "")
+	fs.Uint64Var(&sprintSize, "sprint-size", 0, "")
+	fs.DurationVar(&blockTime, "block-time", 0, "")
+	fs.Uint64Var(&epochReward, "epoch-reward", 0, "")
+	fs.Uint64Var(&maxValidators, "max-validator-count", 0, "")
+	fs.Uint64Var(&blockGasLimit, "block-gas-limit", 0, "")
+	fs.StringVar(&trieRoot, "trieroot", "", "")
+	fs.StringVar(&nativeToken, "native-token-config", "", "")
+	fs.StringVar(&rewardWallet, "reward-wallet", "", "")
+	fs.StringArrayVar(&validatorsRaw, "validators", nil, "")
+	fs.StringArrayVar(&premines, "premine", nil, "")
+	fs.StringArrayVar(&stakes, "stake", nil, "")
+
+	flags, err := config.ToCLIFlags()
+	require.NoError(t, err)
+	require.NoError(t, fs.Parse(flags))
+	require.Empty(t, fs.Args())
+
+	require.Equal(t, ConsensusName, consensus)
+	require.Equal(t, config.EpochSize, epochSize)
+	require.Equal(t, config.SprintSize, sprintSize)
+	require.Equal(t, config.BlockTime.Duration, blockTime)
+	require.Equal(t, config.EpochReward, epochReward)
+	require.Equal(t, config.MaxValidatorSetSize, maxValidators)
+	require.Equal(t, config.BlockGasLimit, blockGasLimit)
+	require.Equal(t, config.InitialTrieRoot, types.StringToHash(trieRoot))
+	require.Equal(t, "Mind:MIND:18:true", nativeToken)
+
+	parseAmount := func(raw string) (types.Address, *big.Int) {
+		addrRaw, amountRaw, found := strings.Cut(raw, ":")
+		require.True(t, found, raw)
+
+		amount, err := types.ParseUint256orHex(&amountRaw)
+		require.NoError(t, err)
+
+		return types.StringToAddress(addrRaw), amount
+	}
+
+	walletAddr, walletAmount := parseAmount(rewardWallet)
+	require.Equal(t, config.RewardConfig.WalletAddress, walletAddr)
+	require.Equal(t, hugeAmount, walletAmount)
+
+	require.Len(t, validatorsRaw, len(validators))
+
+	for i, raw := range validatorsRaw {
+		parts := strings.Split(raw, ":")
+		require.Len(t, parts, 3)
+		require.Equal(t, validators[i].MultiAddr, parts[0])
+		require.Equal(t, validators[i].Address, types.StringToAddress(parts[1]))
+		require.Equal(t, validators[i].BlsKey, parts[2])
+
+		addr, stake := parseAmount(stakes[i])
+		require.Equal(t, validators[i].Address, addr)
+		require.Equal(t, validators[i].Stake, stake)
+	}
+
+	require.Len(t, premines, len(validators)+1)
+
+	addr, amount := parseAmount(premines[len(premines)-1])
+	require.Equal(t, types.StringToAddress("0xdef"), addr)
+	require.Equal(t, big.NewInt(5), amount)
+
+	// validators without the P2P multi address are not passed by the flags
+	validators[1].MultiAddr = ""
+
+	flags, err = config.ToCLIFlags()
+	require.NoError(t, err)
+	require.NotContains(t, strings.Join(flags, " "), "--validators=")
+}
+
+func TestPolyBFTConfig_ToCLIFlags_EpochRewardWei(t *testing.T) {
+	t.Parallel()
+
+	config := &PolyBFTConfig{EpochReward: 7, EpochRewardWei: big.NewInt(1_000_000_000)}
+
+	flags, err := config.ToCLIFlags()
+	require.NoError(t, err)
+	require.Contains(t, flags, "--epoch-reward=1000000000")
+
+	config.EpochRewardWei = new(big.Int).Lsh(big.NewInt(1), 64)
+
+	_, err = config.ToCLIFlags()
+	require.ErrorContains(t, err, "epochRewardWei")
+}
+
+func TestPolyBFTConfig_ToCLIFlags_UnsupportedSettings(t *testing.T) {
+	t.Parallel()
+
+	config := &PolyBFTConfig{
+		EpochReward:    7,
+		MaxEpochReward: big.NewInt(10),
+		AllowList:      []types.Address{types.StringToAddress("0x1")},
+		RewardConfig:   &RewardsConfig{WalletAmount: big.NewInt(1), InflationRate: big.NewInt(200)},
+	}
+
+	flags, err := config.ToCLIFlags()
+	require.Nil(t, flags)
+	require.ErrorContains(t, err, "maxEpochReward, allowList, rewardConfig.rewardInflationRate")
+
+	config.Governance = types.StringToAddress("0x2")
+
+	_, err = config.ToCLIFlags()
+	require.ErrorContains(t, err, "governance")
+}
@@ -0,0 +1,168 @@
+package polybft
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const initialValidatorSetField = "initialValidatorSet"
+
+// ConfigChange represents a change of a single PolyBFTConfig field.
+// Old is empty for added fields, while New is empty for removed ones.
+type ConfigChange struct {
+	Field string
+	Old   string
+	New   string
+}
+
+// String implements fmt.Stringer interface
+func (c ConfigChange) String() string {
+	return fmt.Sprintf("%s: %q -> %q", c.Field, c.Old, c.New)
+}
+
+// DiffPolyBFTConfig returns the changes between two configs, sorted by the field path.
+// Fields are named by their JSON path (e.g. "rewardConfig.rewardWalletAmount" or "bridges.5.exitHelperAddress"),
+// and the values are formatted the same way as in the JSON encoding. Initial validators are matched by the address
+// (e.g. "initialValidatorSet[0x...].stake"), so their order doesn't matter, and a validator which is added or removed
+// is reported as a single change of "initialValidatorSet[<address>]", holding its JSON encoding.
+// Nil config is treated as an empty one.
+func DiffPolyBFTConfig(oldConfig, newConfig *PolyBFTConfig) ([]ConfigChange, error) {
+	oldFields, oldValidators, err := flattenPolyBFTConfig(oldConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to flatten old config: %w", err)
+	}
+
+	newFields, newValidators, err := flattenPolyBFTConfig(newConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to flatten new config: %w", err)
+	}
+
+	// validators present in just one of the configs are reported as a whole, instead of field by field
+	for field, encoded := range oldValidators {
+		if _, ok := newValidators[field]; !ok {
+			removeFieldsWithPrefix(oldFields, field+".")
+			oldFields[field] = encoded
+		}
+	}
+
+	for field, encoded := range newValidators {
+		if _, ok := oldValidators[field]; !ok {
+			removeFieldsWithPrefix(newFields, field+".")
+			newFields[field] = encoded
+		}
+	}
+
+	fields := make([]string, 0, len(oldFields)+len(newFields))
+
+	for field := range oldFields {
+		fields = append(fields, field)
+	}
+
+	for field := range newFields {
+		if _, ok := oldFields[field]; !ok {
+			fields = append(fields, field)
+		}
+	}
+
+	sort.Strings(fields)
+
+	changes := make([]ConfigChange, 0)
+
+	for _, field := range fields {
+		if oldValue, newValue := oldFields[field], newFields[field]; oldValue != newValue {
+			changes = append(changes, ConfigChange{Field: field, Old: oldValue, New: newValue})
+		}
+	}
+
+	return changes, nil
+}
+
+// flattenPolyBFTConfig converts the config to the map of leaf field paths to their values.
+// It also returns JSON encodings of the initial validators, keyed by their field path.
+func flattenPolyBFTConfig(config *PolyBFTConfig) (map[string]string, map[string]string, error) {
+	fields, validators := map[string]string{}, map[string]string{}
+	if config == nil {
+		return fields, validators, nil
+	}
+
+	data, err := json.Marshal(config)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var raw map[string]interface{}
+	if err := decoder.Decode(&raw); err != nil {
+		return nil, nil, err
+	}
+
+	rawValidators, _ := raw[initialValidatorSetField].([]interface{})
+	delete(raw, initialValidatorSetField)
+
+	for _, v := range rawValidators {
+		validatorFields, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		encoded, err := json.Marshal(validatorFields)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		field := fmt.Sprintf("%s[%v]", initialValidatorSetField, validatorFields["address"])
+		validators[field] = string(encoded)
+
+		flattenValue(field, validatorFields, fields)
+	}
+
+	flattenValue("", raw, fields)
+
+	if config.ChainID != 0 {
+		fields["chainID"] = strconv.FormatInt(config.ChainID, 10)
+	}
+
+	return fields, validators, nil
+}
+
+// removeFieldsWithPrefix removes the fields whose path starts with the given prefix
+func removeFieldsWithPrefix(fields map[string]string, prefix string) {
+	for field := range fields {
+		if strings.HasPrefix(field, prefix) {
+			delete(fields, field)
+		}
+	}
+}
+
+// flattenValue collects leaf values of the decoded JSON value under the given path prefix.
+// Null values and empty objects or arrays are omitted.
+func flattenValue(path string, value interface{}, fields map[string]string) {
+	join := func(key string) string {
+		if path == "" {
+			return key
+		}
+
+		return path + "." + key
+	}
+
+	switch v := value.(type) {
+	case nil:
+		return
+	case map[string]interface{}:
+		for key, item := range v {
+			flattenValue(join(key), item, fields)
+		}
+	case []interface{}:
+		for i, item := range v {
+			flattenValue(fmt.Sprintf("%s[%d]", path, i), item, fields)
+		}
+	default:
+		fields[path] = fmt.Sprint(v)
+	}
+}
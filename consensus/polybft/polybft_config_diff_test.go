@@ -0,0 +1,114 @@
+package polybft
+
+import (
+	"math/big"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/validator"
+	"github.com/0xPolygon/polygon-edge/helper/common"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffPolyBFTConfig(t *testing.T) {
+	t.Parallel()
+
+	newValidator := func(addr string, stake int64) *validator.GenesisValidator {
+		return &validator.GenesisValidator{
+			Address: types.StringToAddress(addr),
+			Balance: big.NewInt(1),
+			Stake:   big.NewInt(stake),
+		}
+	}
+
+	oldConfig := &PolyBFTConfig{
+		InitialValidatorSet: []*validator.GenesisValidator{newValidator("1", 10), newValidator("2", 10)},
+		EpochSize:           10,
+		SprintSize:          5,
+		BlockTime:           common.Duration{Duration: 2 * time.Second},
+		MaxValidatorSetSize: 100,
+		RewardConfig: &RewardsConfig{
+			TokenAddress:  types.StringToAddress("10"),
+			WalletAddress: types.StringToAddress("11"),
+			WalletAmount:  big.NewInt(1000),
+		},
+		ChainID: 100,
+	}
+
+	t.Run("no changes", func(t *testing.T) {
+		t.Parallel()
+
+		changes, err := DiffPolyBFTConfig(oldConfig, oldConfig.Copy())
+		require.NoError(t, err)
+		require.Empty(t, changes)
+	})
+
+	t.Run("changed fields", func(t *testing.T) {
+		t.Parallel()
+
+		newConfig := oldConfig.Copy()
+		newConfig.EpochSize = 20
+		newConfig.RewardConfig.WalletAmount = big.NewInt(2000)
+		newConfig.InitialValidatorSet = []*validator.GenesisValidator{newValidator("3", 10), newValidator("1", 20)}
+		newConfig.Bridges = map[uint64]*BridgeConfig{5: {JSONRPCEndpoint: "http://localhost:8545"}}
+
+		changes, err := DiffPolyBFTConfig(oldConfig, newConfig)
+		require.NoError(t, err)
+
+		addr1, addr2, addr3 := types.StringToAddress("1"), types.StringToAddress("2"), types.StringToAddress("3")
+
+		byField := make(map[string]ConfigChange, len(changes))
+		for _, change := range changes {
+			byField[change.Field] = change
+		}
+
+		require.Equal(t, ConfigChange{Field: "epochSize", Old: "10", New: "20"}, byField["epochSize"])
+		require.Equal(t,
+			ConfigChange{Field: "rewardConfig.rewardWalletAmount", Old: "0x3e8", New: "0x7d0"},
+			byField["rewardConfig.rewardWalletAmount"])
+		require.Equal(t,
+			ConfigChange{Field: "bridges.5.jsonRPCEndpoint", Old: "", New: "http://localhost:8545"},
+			byField["bridges.5.jsonRPCEndpoint"])
+
+		// validator present in both configs is compared field by field, regardless of its position
+		stakeField := "initialValidatorSet[" + addr1.String() + "].stake"
+		require.Equal(t, ConfigChange{Field: stakeField, Old: "0xa", New: "0x14"}, byField[stakeField])
+
+		// removed validator
+		removed := byField["initialValidatorSet["+addr2.String()+"]"]
+		require.Contains(t, removed.Old, addr2.String())
+		require.Empty(t, removed.New)
+
+		// added validator
+		added := byField["initialValidatorSet["+addr3.String()+"]"]
+		require.Empty(t, added.Old)
+		require.Contains(t, added.New, addr3.String())
+
+		for field := range byField {
+			require.NotContains(t, field, addr3.String()+"].")
+		}
+
+		// ordering is stable
+		require.True(t, sort.SliceIsSorted(changes, func(i, j int) bool { return changes[i].Field < changes[j].Field }))
+
+		again, err := DiffPolyBFTConfig(oldConfig, newConfig)
+		require.NoError(t, err)
+		require.Equal(t, changes, again)
+	})
+
+	t.Run("nil config", func(t *testing.T) {
+		t.Parallel()
+
+		changes, err := DiffPolyBFTConfig(nil, oldConfig)
+		require.NoError(t, err)
+		require.NotEmpty(t, changes)
+
+		for _, change := range changes {
+			require.Empty(t, change.Old)
+		}
+
+		require.Contains(t, changes, ConfigChange{Field: "chainID", Old: "", New: "100"})
+	})
+}
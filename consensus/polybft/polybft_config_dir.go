@@ -0,0 +1,93 @@
+package polybft
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/go-hclog"
+	"golang.org/x/sync/errgroup"
+)
+
+// ValidateConfigDir loads and validates the polybft config of every chain config (*.json file) in the given
+// directory, running at most concurrency validations at once. It returns the validation result of each file,
+// keyed by the file path (nil for valid configs, validation warnings are not reported).
+// If the context is cancelled, the results of the files validated so far are returned along with the context error.
+func ValidateConfigDir(ctx context.Context, dir string, concurrency int) (map[string]error, error) {
+	if concurrency < 1 {
+		return nil, fmt.Errorf("concurrency must be greater than 0 (concurrency=%d)", concurrency)
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config directory %s: %w", dir, err)
+	}
+
+	paths := make([]string, 0, len(entries))
+
+	for _, entry := range entries {
+		if !entry.IsDir() && strings.EqualFold(filepath.Ext(entry.Name()), ".json") {
+			paths = append(paths, filepath.Join(dir, entry.Name()))
+		}
+	}
+
+	sort.Strings(paths)
+
+	var (
+		results = make(map[string]error, len(paths))
+		lock    sync.Mutex
+	)
+
+	g, gCtx := errgroup.WithContext(ctx)
+	g.SetLimit(concurrency)
+
+	for _, path := range paths {
+		// stop scheduling the validations as soon as the context is cancelled
+		if gCtx.Err() != nil {
+			break
+		}
+
+		path := path
+
+		g.Go(func() error {
+			if err := gCtx.Err(); err != nil {
+				return err
+			}
+
+			validationErr := validateConfigFile(path)
+
+			lock.Lock()
+			results[path] = validationErr
+			lock.Unlock()
+
+			return nil
+		})
+	}
+
+	// validations never fail the group, so only the context cancellation is reported
+	_ = g.Wait()
+
+	if err := ctx.Err(); err != nil {
+		return results, err
+	}
+
+	return results, nil
+}
+
+// validateConfigFile loads the chain config from the given path and validates its polybft config
+func validateConfigFile(path string) error {
+	chainCfg, err := importChainConfig(path)
+	if err != nil {
+		return fmt.Errorf("failed to import chain config: %w", err)
+	}
+
+	if _, err := GetPolyBFTConfig(chainCfg, WithValidation(), WithLogger(hclog.NewNullLogger())); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,74 @@
+package polybft
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateConfigDir(t *testing.T) {
+	t.Parallel()
+
+	writeConfigs := func(t *testing.T, validCount int) string {
+		t.Helper()
+
+		dir := t.TempDir()
+
+		for i := 0; i < validCount; i++ {
+			config := MinimalValidPolyBFTConfig()
+			writeTestChainConfig(t, filepath.Join(dir, fmt.Sprintf("valid-%d.json", i)), int64(100+i), &config)
+		}
+
+		invalid := MinimalValidPolyBFTConfig()
+		invalid.SprintSize = 0
+
+		writeTestChainConfig(t, filepath.Join(dir, "invalid.json"), 1, &invalid)
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "malformed.json"), []byte("{"), 0600))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "README.md"), []byte("not a config"), 0600))
+		require.NoError(t, os.Mkdir(filepath.Join(dir, "nested.json"), 0700))
+
+		return dir
+	}
+
+	t.Run("per file results", func(t *testing.T) {
+		t.Parallel()
+
+		dir := writeConfigs(t, 8)
+
+		results, err := ValidateConfigDir(context.Background(), dir, 3)
+		require.NoError(t, err)
+		require.Len(t, results, 10)
+
+		for i := 0; i < 8; i++ {
+			require.NoError(t, results[filepath.Join(dir, fmt.Sprintf("valid-%d.json", i))])
+		}
+
+		require.ErrorContains(t, results[filepath.Join(dir, "invalid.json")], "sprintSize must be greater than 0")
+		require.ErrorContains(t, results[filepath.Join(dir, "malformed.json")], "failed to import chain config")
+	})
+
+	t.Run("cancelled context", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		results, err := ValidateConfigDir(ctx, writeConfigs(t, 4), 2)
+		require.ErrorIs(t, err, context.Canceled)
+		require.Empty(t, results)
+	})
+
+	t.Run("invalid arguments", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ValidateConfigDir(context.Background(), t.TempDir(), 0)
+		require.ErrorContains(t, err, "concurrency must be greater than 0")
+
+		_, err = ValidateConfigDir(context.Background(), filepath.Join(t.TempDir(), "missing"), 1)
+		require.ErrorContains(t, err, "failed to read config directory")
+	})
+}
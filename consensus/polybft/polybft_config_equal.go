@@ -0,0 +1,314 @@
+package polybft
+
+import (
+	"bytes"
+	"math/big"
+
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/validator"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// Equal checks whether the two configs are semantically equal. Unlike reflect.DeepEqual, it compares
+// big integers by value, maps by content and it treats nil and empty slices and maps as equal.
+// ChainID and private keys of the genesis validators are not compared, since they are not a part
+// of the config encoding (the same way as they don't affect Hash).
+func (p *PolyBFTConfig) Equal(other *PolyBFTConfig) bool {
+	if p == nil || other == nil {
+		return p == other
+	}
+
+	return p.ConfigVersion == other.ConfigVersion &&
+		p.MinNodeVersion == other.MinNodeVersion &&
+		genesisValidatorsEqual(p.InitialValidatorSet, other.InitialValidatorSet) &&
+		p.Bridge.Equal(other.Bridge) &&
+		bridgesEqual(p.Bridges, other.Bridges) &&
+		p.EpochSize == other.EpochSize &&
+		p.EpochReward == other.EpochReward &&
+		bigIntEqual(p.EpochRewardWei, other.EpochRewardWei) &&
+		bigIntEqual(p.MaxEpochReward, other.MaxEpochReward) &&
+		p.SprintSize == other.SprintSize &&
+		p.BlockTime.Duration == other.BlockTime.Duration &&
+		p.BlockTimeDrift.Duration == other.BlockTimeDrift.Duration &&
+		p.BlockTimeMax.Duration == other.BlockTimeMax.Duration &&
+		p.Governance == other.Governance &&
+		tokenConfigEqual(p.NativeTokenConfig, other.NativeTokenConfig) &&
+		p.InitialTrieRoot == other.InitialTrieRoot &&
+		p.MaxValidatorSetSize == other.MaxValidatorSetSize &&
+		sizeChangesEqual(p.ValidatorSetSizeSchedule, other.ValidatorSetSizeSchedule) &&
+		addressesEqual(p.ExcludedValidators, other.ExcludedValidators) &&
+		p.RewardConfig.Equal(other.RewardConfig) &&
+		p.WithdrawalWaitPeriod == other.WithdrawalWaitPeriod &&
+		p.MinFundedEpochs == other.MinFundedEpochs &&
+		tokenMintsEqual(p.PremineMints, other.PremineMints) &&
+		slashingConfigEqual(p.SlashingConfig, other.SlashingConfig) &&
+		baseFeeConfigEqual(p.BaseFeeConfig, other.BaseFeeConfig) &&
+		faucetConfigEqual(p.FaucetConfig, other.FaucetConfig) &&
+		addressesEqual(p.AllowList, other.AllowList) &&
+		addressesEqual(p.BlockList, other.BlockList) &&
+		p.BlockGasLimit == other.BlockGasLimit &&
+		p.BlockGasTarget == other.BlockGasTarget &&
+		genesisContractsEqual(p.GenesisContracts, other.GenesisContracts) &&
+		scheduledChangesEqual(p.ParamSchedule, other.ParamSchedule) &&
+		bigIntEqual(p.MinValidatorStake, other.MinValidatorStake)
+}
+
+// Equal checks whether the two bridge configs are semantically equal
+func (b *BridgeConfig) Equal(other *BridgeConfig) bool {
+	if b == nil || other == nil {
+		return b == other
+	}
+
+	if b.StateSenderAddr != other.StateSenderAddr ||
+		b.CheckpointManagerAddr != other.CheckpointManagerAddr ||
+		b.ExitHelperAddr != other.ExitHelperAddr ||
+		b.RootERC20PredicateAddr != other.RootERC20PredicateAddr ||
+		b.RootNativeERC20Addr != other.RootNativeERC20Addr ||
+		b.RootERC721Addr != other.RootERC721Addr ||
+		b.RootERC721PredicateAddr != other.RootERC721PredicateAddr ||
+		b.RootERC1155Addr != other.RootERC1155Addr ||
+		b.RootERC1155PredicateAddr != other.RootERC1155PredicateAddr ||
+		b.CustomSupernetManagerAddr != other.CustomSupernetManagerAddr ||
+		b.StakeManagerAddr != other.StakeManagerAddr ||
+		b.ProxyAdminAddr != other.ProxyAdminAddr ||
+		b.JSONRPCEndpoint != other.JSONRPCEndpoint ||
+		b.CheckpointInterval != other.CheckpointInterval ||
+		b.MaxBridgeBatchSize != other.MaxBridgeBatchSize ||
+		b.ExplorerURL != other.ExplorerURL ||
+		b.BridgeActivationBlock != other.BridgeActivationBlock ||
+		b.IsEVMRootchain() != other.IsEVMRootchain() ||
+		(!b.IsEVMRootchain() && b.RootchainKind != other.RootchainKind) {
+		return false
+	}
+
+	if len(b.JSONRPCEndpoints) != len(other.JSONRPCEndpoints) ||
+		len(b.EventTrackerStartBlocks) != len(other.EventTrackerStartBlocks) ||
+		len(b.Extra) != len(other.Extra) ||
+		len(b.PausedPredicates) != len(other.PausedPredicates) {
+		return false
+	}
+
+	for i, standard := range b.PausedPredicates {
+		if standard != other.PausedPredicates[i] {
+			return false
+		}
+	}
+
+	for key, value := range b.Extra {
+		if otherValue, ok := other.Extra[key]; !ok || value != otherValue {
+			return false
+		}
+	}
+
+	for i, endpoint := range b.JSONRPCEndpoints {
+		if endpoint != other.JSONRPCEndpoints[i] {
+			return false
+		}
+	}
+
+	for addr, block := range b.EventTrackerStartBlocks {
+		if otherBlock, ok := other.EventTrackerStartBlocks[addr]; !ok || block != otherBlock {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equal checks whether the two rewards configs are semantically equal.
+// Nil (unlimited) wallet amount equals only the unlimited one.
+func (r *RewardsConfig) Equal(other *RewardsConfig) bool {
+	if r == nil || other == nil {
+		return r == other
+	}
+
+	return r.TokenAddress == other.TokenAddress &&
+		r.WalletAddress == other.WalletAddress &&
+		bigIntEqual(r.WalletAmount, other.WalletAmount) &&
+		bigIntEqual(r.InflationRate, other.InflationRate) &&
+		r.EffectiveRewardSource() == other.EffectiveRewardSource() &&
+		r.EffectiveRewardDistribution() == other.EffectiveRewardDistribution()
+}
+
+// bigIntEqual checks whether the two big integers are either both nil or equal by value
+func bigIntEqual(a, b *big.Int) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return a.Cmp(b) == 0
+}
+
+func tokenConfigEqual(a, b *TokenConfig) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return a.Name == b.Name &&
+		a.Symbol == b.Symbol &&
+		a.Decimals == b.Decimals &&
+		a.IsMintable == b.IsMintable &&
+		bigIntEqual(a.TotalSupply, b.TotalSupply) &&
+		a.LogoURI == b.LogoURI &&
+		a.Description == b.Description
+}
+
+func slashingConfigEqual(a, b *SlashingConfig) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return bigIntEqual(a.DoubleSignPenalty, b.DoubleSignPenalty) &&
+		bigIntEqual(a.DowntimePenalty, b.DowntimePenalty) &&
+		a.DowntimeEpochThreshold == b.DowntimeEpochThreshold
+}
+
+func baseFeeConfigEqual(a, b *BaseFeeConfig) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return a.BaseFeeChangeDenom == b.BaseFeeChangeDenom &&
+		a.ElasticityMultiplier == b.ElasticityMultiplier &&
+		bigIntEqual(a.InitialBaseFee, b.InitialBaseFee)
+}
+
+func genesisValidatorsEqual(a, b []*validator.GenesisValidator) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i, v := range a {
+		other := b[i]
+		if v == nil || other == nil {
+			if v != other {
+				return false
+			}
+
+			continue
+		}
+
+		if v.Address != other.Address || v.BlsKey != other.BlsKey || v.MultiAddr != other.MultiAddr ||
+			!bigIntEqual(v.Balance, other.Balance) || !bigIntEqual(v.Stake, other.Stake) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func bridgesEqual(a, b map[uint64]*BridgeConfig) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for chainID, bridge := range a {
+		if other, ok := b[chainID]; !ok || !bridge.Equal(other) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func sizeChangesEqual(a, b []SizeChange) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i, change := range a {
+		if change != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func scheduledChangesEqual(a, b []ScheduledChange) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i, change := range a {
+		if change != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func addressesEqual(a, b []types.Address) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i, addr := range a {
+		if addr != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func tokenMintsEqual(a, b []*TokenMint) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i, mint := range a {
+		other := b[i]
+		if mint == nil || other == nil {
+			if mint != other {
+				return false
+			}
+
+			continue
+		}
+
+		if mint.Address != other.Address || !bigIntEqual(mint.Amount, other.Amount) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func faucetConfigEqual(a, b *FaucetConfig) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return a.Address == b.Address && bigIntEqual(a.Amount, b.Amount)
+}
+
+func genesisContractsEqual(a, b []*GenesisContract) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i, contract := range a {
+		other := b[i]
+		if contract == nil || other == nil {
+			if contract != other {
+				return false
+			}
+
+			continue
+		}
+
+		if contract.Address != other.Address || !bytes.Equal(contract.Code, other.Code) ||
+			!bigIntEqual(contract.Balance, other.Balance) || len(contract.Storage) != len(other.Storage) {
+			return false
+		}
+
+		for key, value := range contract.Storage {
+			if otherValue, ok := other.Storage[key]; !ok || value != otherValue {
+				return false
+			}
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,102 @@
+package polybft
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/validator"
+	"github.com/0xPolygon/polygon-edge/helper/common"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPolyBFTConfig_Equal(t *testing.T) {
+	t.Parallel()
+
+	newConfig := func() *PolyBFTConfig {
+		return &PolyBFTConfig{
+			ConfigVersion: CurrentPolyBFTConfigVersion,
+			InitialValidatorSet: []*validator.GenesisValidator{
+				{Address: types.StringToAddress("1"), BlsKey: "aa", Balance: big.NewInt(1), Stake: big.NewInt(100)},
+			},
+			Bridge: &BridgeConfig{
+				StateSenderAddr:         types.StringToAddress("2"),
+				JSONRPCEndpoint:         "http://127.0.0.1:8545",
+				EventTrackerStartBlocks: map[types.Address]uint64{types.StringToAddress("2"): 10},
+			},
+			Bridges:             map[uint64]*BridgeConfig{5: {JSONRPCEndpoint: "http://127.0.0.1:9545"}},
+			EpochSize:           10,
+			EpochRewardWei:      big.NewInt(1000),
+			SprintSize:          5,
+			BlockTime:           common.Duration{Duration: 2 * time.Second},
+			Governance:          types.StringToAddress("3"),
+			NativeTokenConfig:   &TokenConfig{Name: "Mind", Symbol: "MIND", Decimals: 18, IsMintable: true},
+			MaxValidatorSetSize: 100,
+			RewardConfig: &RewardsConfig{
+				TokenAddress:  types.StringToAddress("4"),
+				WalletAddress: types.StringToAddress("5"),
+				WalletAmount:  big.NewInt(1_000_000),
+			},
+			PremineMints: []*TokenMint{{Address: types.StringToAddress("6"), Amount: big.NewInt(7)}},
+		}
+	}
+
+	t.Run("JSON round trip", func(t *testing.T) {
+		t.Parallel()
+
+		config := newConfig()
+
+		data, err := json.Marshal(config)
+		require.NoError(t, err)
+
+		var decoded PolyBFTConfig
+
+		require.NoError(t, json.Unmarshal(data, &decoded))
+		require.True(t, config.Equal(&decoded))
+	})
+
+	t.Run("cosmetic differences", func(t *testing.T) {
+		t.Parallel()
+
+		config, other := newConfig(), newConfig()
+
+		// different internal representation of the same value
+		other.EpochRewardWei = new(big.Int).Sub(big.NewInt(2000), big.NewInt(1000))
+		other.ExcludedValidators = []types.Address{}
+		config.Bridge.JSONRPCEndpoints = []string{}
+		other.RewardConfig.WalletAmount = new(big.Int).Mul(big.NewInt(1000), big.NewInt(1000))
+		other.ChainID = 100
+
+		require.True(t, config.Equal(other))
+	})
+
+	t.Run("semantic differences", func(t *testing.T) {
+		t.Parallel()
+
+		cases := map[string]func(config *PolyBFTConfig){
+			"epoch size":       func(config *PolyBFTConfig) { config.EpochSize = 20 },
+			"unlimited wallet": func(config *PolyBFTConfig) { config.RewardConfig.WalletAmount = nil },
+			"epoch reward":     func(config *PolyBFTConfig) { config.EpochRewardWei = nil },
+			"validator stake":  func(config *PolyBFTConfig) { config.InitialValidatorSet[0].Stake = big.NewInt(1) },
+			"start block":      func(config *PolyBFTConfig) { config.Bridge.EventTrackerStartBlocks = nil },
+			"bridges":          func(config *PolyBFTConfig) { config.Bridges[6] = nil },
+			"token":            func(config *PolyBFTConfig) { config.NativeTokenConfig.IsMintable = false },
+			"reward wallet":    func(config *PolyBFTConfig) { config.RewardConfig.WalletAmount = big.NewInt(1) },
+			"premine mints":    func(config *PolyBFTConfig) { config.PremineMints = nil },
+			"no bridge":        func(config *PolyBFTConfig) { config.Bridge = nil },
+		}
+
+		for name, modify := range cases {
+			config, other := newConfig(), newConfig()
+			modify(other)
+
+			require.False(t, config.Equal(other), name)
+			require.False(t, other.Equal(config), name)
+		}
+	})
+
+	require.True(t, (*PolyBFTConfig)(nil).Equal(nil))
+	require.False(t, (*PolyBFTConfig)(nil).Equal(&PolyBFTConfig{}))
+}
@@ -0,0 +1,97 @@
+package polybft
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+
+	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/hashicorp/go-multierror"
+)
+
+var (
+	// ErrConfigNotFound is returned by the config loaders when the config file doesn't exist
+	ErrConfigNotFound = errors.New("polybft config not found")
+
+	// ErrConfigMalformed is returned by the config loaders when the config can't be decoded
+	ErrConfigMalformed = errors.New("polybft config is malformed")
+
+	// ErrConfigInvalid is returned when the polybft config doesn't pass the validation,
+	// both by PolyBFTConfig.Validate and by the config loaders which validate the config
+	ErrConfigInvalid = errors.New("polybft config is invalid")
+)
+
+// configLoadError classifies the error of the config loaders (and of the validation) with one of
+// the ErrConfigNotFound, ErrConfigMalformed or ErrConfigInvalid sentinels. It matches only its own sentinel,
+// while the underlying error remains reachable through Unwrap (e.g. fs.ErrNotExist or the validation errors).
+type configLoadError struct {
+	kind error
+	err  error
+}
+
+// newConfigLoadError wraps the given error into the configLoadError of the given kind
+func newConfigLoadError(kind, err error) error {
+	return &configLoadError{kind: kind, err: err}
+}
+
+func (e *configLoadError) Error() string {
+	return fmt.Sprintf("%s: %s", e.kind, e.err)
+}
+
+func (e *configLoadError) Unwrap() error {
+	return e.err
+}
+
+func (e *configLoadError) Is(target error) bool {
+	return target == e.kind
+}
+
+// classifyValidationError classifies the error aggregated by PolyBFTConfig.Validate as ErrConfigInvalid,
+// unless it consists of the warnings only, which don't render the config invalid
+func classifyValidationError(err error) error {
+	var merr *multierror.Error
+	if !errors.As(err, &merr) {
+		return err
+	}
+
+	for _, aggregatedErr := range merr.Errors {
+		var warning *ConfigWarning
+		if !errors.As(aggregatedErr, &warning) {
+			return newConfigLoadError(ErrConfigInvalid, err)
+		}
+	}
+
+	return err
+}
+
+// readConfigFile reads the config file, classifying the missing file as ErrConfigNotFound
+func readConfigFile(path string) ([]byte, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, newConfigLoadError(ErrConfigNotFound, err)
+		}
+
+		return nil, err
+	}
+
+	return data, nil
+}
+
+// importChainConfig imports the chain config from the given path, classifying the missing file
+// as ErrConfigNotFound and the decoding failures as ErrConfigMalformed
+func importChainConfig(path string) (*chain.Chain, error) {
+	data, err := readConfigFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	chainCfg, err := chain.ImportFromReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, newConfigLoadError(ErrConfigMalformed, err)
+	}
+
+	return chainCfg, nil
+}
@@ -0,0 +1,106 @@
+package polybft
+
+import (
+	"context"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPolyBFTConfig_LoaderSentinelErrors(t *testing.T) {
+	t.Parallel()
+
+	writeChainConfig := func(t *testing.T, config PolyBFTConfig) string {
+		t.Helper()
+
+		path := filepath.Join(t.TempDir(), "genesis.json")
+		writeTestChainConfig(t, path, 100, &config)
+
+		return path
+	}
+
+	t.Run("not found", func(t *testing.T) {
+		t.Parallel()
+
+		path := filepath.Join(t.TempDir(), "missing.json")
+
+		_, _, err := LoadPolyBFTConfig(path)
+		require.ErrorIs(t, err, ErrConfigNotFound)
+		require.ErrorIs(t, err, fs.ErrNotExist)
+		require.NotErrorIs(t, err, ErrConfigInvalid)
+		require.NotErrorIs(t, err, ErrConfigMalformed)
+
+		_, _, err = LoadPolyBFTConfigFromYAML(path)
+		require.ErrorIs(t, err, ErrConfigNotFound)
+
+		_, err = LoadBridgeConfig(path)
+		require.ErrorIs(t, err, ErrConfigNotFound)
+	})
+
+	t.Run("malformed", func(t *testing.T) {
+		t.Parallel()
+
+		path := filepath.Join(t.TempDir(), "genesis.json")
+		require.NoError(t, os.WriteFile(path, []byte("{"), 0600))
+
+		_, _, err := LoadPolyBFTConfig(path)
+		require.ErrorIs(t, err, ErrConfigMalformed)
+		require.NotErrorIs(t, err, ErrConfigNotFound)
+		require.NotErrorIs(t, err, ErrConfigInvalid)
+
+		_, _, err = LoadPolyBFTConfigFromReader(strings.NewReader(`{"params": {"engine": {"polybft": 1}}}`))
+		require.ErrorIs(t, err, ErrConfigMalformed)
+
+		_, err = LoadBridgeConfig(path)
+		require.ErrorIs(t, err, ErrConfigMalformed)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		t.Parallel()
+
+		config := MinimalValidPolyBFTConfig()
+		config.EpochSize = 0
+
+		_, err := LoadAndVerifyPolyBFTConfig(context.Background(), writeChainConfig(t, config))
+		require.ErrorIs(t, err, ErrConfigInvalid)
+		require.NotErrorIs(t, err, ErrConfigNotFound)
+		require.ErrorContains(t, err, "epochSize must be greater than 0")
+
+		_, err = config.ToChainEngine()
+		require.ErrorIs(t, err, ErrConfigInvalid)
+
+		// loading without the validation succeeds
+		_, _, err = LoadPolyBFTConfig(writeChainConfig(t, config))
+		require.NoError(t, err)
+	})
+}
+
+func TestPolyBFTConfig_ValidateSentinelError(t *testing.T) {
+	t.Parallel()
+
+	config := MinimalValidPolyBFTConfig()
+	config.EpochSize = 0
+
+	err := config.Validate()
+	require.ErrorIs(t, err, ErrConfigInvalid)
+
+	_, validationErr := SplitValidationWarnings(err)
+	require.ErrorIs(t, validationErr, ErrConfigInvalid)
+
+	// the warnings alone don't render the config invalid
+	config = MinimalValidPolyBFTConfig()
+	config.ExcludedValidators = []types.Address{types.StringToAddress("ff")}
+
+	err = config.Validate()
+	require.Error(t, err)
+	require.NotErrorIs(t, err, ErrConfigInvalid)
+
+	warnings, validationErr := SplitValidationWarnings(err)
+	require.NoError(t, validationErr)
+	require.Len(t, warnings, 1)
+}
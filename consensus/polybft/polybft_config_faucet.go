@@ -0,0 +1,80 @@
+package polybft
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/go-multierror"
+)
+
+// HasFaucet indicates whether the faucet account is premined at genesis,
+// that is if FaucetConfig is set and its amount is greater than zero
+func (p *PolyBFTConfig) HasFaucet() bool {
+	return p.FaucetConfig != nil && p.FaucetConfig.Amount != nil && p.FaucetConfig.Amount.Sign() > 0
+}
+
+// FaucetConfig is the faucet account, premined with the given amount (in the native token base units) at genesis
+type FaucetConfig struct {
+	Address types.Address
+	Amount  *big.Int
+}
+
+type faucetConfigRaw struct {
+	Address types.Address `json:"address"`
+	Amount  *string       `json:"amount"`
+}
+
+func (f *FaucetConfig) MarshalJSON() ([]byte, error) {
+	raw := &faucetConfigRaw{Address: f.Address}
+
+	if f.Amount != nil {
+		raw.Amount = types.EncodeBigInt(f.Amount)
+	}
+
+	return json.Marshal(raw)
+}
+
+func (f *FaucetConfig) UnmarshalJSON(data []byte) error {
+	var (
+		raw faucetConfigRaw
+		err error
+	)
+
+	if err = json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	f.Address = raw.Address
+
+	f.Amount, err = types.ParseUint256orHex(raw.Amount)
+	if err != nil {
+		return fmt.Errorf("amount: %w", err)
+	}
+
+	return nil
+}
+
+// Copy returns a deep copy of the FaucetConfig
+func (f *FaucetConfig) Copy() *FaucetConfig {
+	cp := *f
+	cp.Amount = copyBigInt(f.Amount)
+
+	return &cp
+}
+
+// Validate checks that the faucet address is set and that the amount is not negative
+func (f *FaucetConfig) Validate() error {
+	var err error
+
+	if f.Address == types.ZeroAddress {
+		err = multierror.Append(err, fieldErrorf("address", "address must not be zero address (address=%s)", f.Address))
+	}
+
+	if f.Amount == nil || f.Amount.Sign() < 0 {
+		err = multierror.Append(err, fieldErrorf("amount", "amount must be non-negative (amount=%v)", f.Amount))
+	}
+
+	return err
+}
@@ -0,0 +1,42 @@
+package polybft
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPolyBFTConfig_Faucet(t *testing.T) {
+	t.Parallel()
+
+	config := MinimalValidPolyBFTConfig()
+	require.False(t, config.HasFaucet())
+
+	config.FaucetConfig = &FaucetConfig{Amount: big.NewInt(-1)}
+	require.False(t, config.HasFaucet())
+
+	err := config.Validate()
+	require.ErrorContains(t, err, "faucetConfig: 2 errors occurred")
+	require.ErrorContains(t, err, "address must not be zero address")
+	require.ErrorContains(t, err, "amount must be non-negative (amount=-1)")
+
+	config.FaucetConfig = &FaucetConfig{Address: types.StringToAddress("0xfa"), Amount: big.NewInt(1000)}
+	require.NoError(t, config.Validate())
+	require.True(t, config.HasFaucet())
+
+	data, err := json.Marshal(config)
+	require.NoError(t, err)
+	require.Contains(t, string(data), `"faucetConfig":{"address":"0x00000000000000000000000000000000000000fa",`+
+		`"amount":"0x3e8"}`)
+
+	var decoded PolyBFTConfig
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	require.True(t, config.Equal(&decoded))
+
+	cp := config.Copy()
+	cp.FaucetConfig.Amount.SetInt64(1)
+	require.Equal(t, big.NewInt(1000), config.FaucetConfig.Amount)
+}
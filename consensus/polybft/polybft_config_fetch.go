@@ -0,0 +1,69 @@
+package polybft
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/chain"
+)
+
+// chainConfigRPCMethod is the JSON RPC method which returns the chain params the node booted with
+const chainConfigRPCMethod = "debug_chainConfig"
+
+// ErrNotPolyBFTChain is returned when the remote chain doesn't run polybft consensus
+var ErrNotPolyBFTChain = errors.New("chain is not running polybft consensus")
+
+// FetchPolyBFTConfig retrieves the chain params from the running node exposed at provided JSON RPC URL
+// and returns the polybft config it booted with. It returns as soon as the context is done.
+func FetchPolyBFTConfig(ctx context.Context, jsonRPCURL string) (PolyBFTConfig, error) {
+	var params chain.Params
+
+	err := callJSONRPC(ctx, jsonRPCURL, func(rpc jsonRPCCallFn) error {
+		return rpc(chainConfigRPCMethod, &params)
+	})
+	if err != nil {
+		return PolyBFTConfig{}, fmt.Errorf("failed to fetch chain config from %s: %w", jsonRPCURL, err)
+	}
+
+	if _, ok := params.Engine[ConsensusName]; !ok {
+		return PolyBFTConfig{}, fmt.Errorf("%w: %s runs %q consensus", ErrNotPolyBFTChain, jsonRPCURL, params.GetEngine())
+	}
+
+	// the local overrides (e.g. the bridge JSON RPC endpoint) are meant for this node, not the remote one
+	return GetPolyBFTConfig(&chain.Chain{Params: &params}, WithoutLocalOverrides())
+}
+
+// RedactChainParams returns a copy of the chain params whose polybft engine config is redacted
+// (see PolyBFTConfig.Redacted), so that they can be served through the chainConfigRPCMethod.
+// The params of the chains which don't run polybft consensus are returned as they are.
+func RedactChainParams(params *chain.Params) (*chain.Params, error) {
+	if params == nil {
+		return nil, nil
+	}
+
+	if _, ok := params.Engine[ConsensusName]; !ok {
+		return params, nil
+	}
+
+	config, err := GetPolyBFTConfig(&chain.Chain{Params: params}, WithoutLocalOverrides())
+	if err != nil {
+		return nil, err
+	}
+
+	engine, err := config.Redacted().encodeChainEngine()
+	if err != nil {
+		return nil, err
+	}
+
+	cp := *params
+	cp.Engine = make(map[string]interface{}, len(params.Engine))
+
+	for name, engineConfig := range params.Engine {
+		cp.Engine[name] = engineConfig
+	}
+
+	cp.Engine[ConsensusName] = engine[ConsensusName]
+
+	return &cp, nil
+}
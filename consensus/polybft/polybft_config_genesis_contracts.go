@@ -0,0 +1,106 @@
+package polybft
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/go-multierror"
+)
+
+// HasGenesisContracts indicates whether any contracts are deployed at genesis
+func (p *PolyBFTConfig) HasGenesisContracts() bool {
+	return len(p.GenesisContracts) > 0
+}
+
+// GenesisContract is the contract deployed at genesis, along with its balance and the initial storage
+type GenesisContract struct {
+	Address types.Address
+	Code    []byte
+	Balance *big.Int
+	Storage map[types.Hash]types.Hash
+}
+
+type genesisContractRaw struct {
+	Address types.Address             `json:"address"`
+	Code    string                    `json:"code"`
+	Balance *string                   `json:"balance,omitempty"`
+	Storage map[types.Hash]types.Hash `json:"storage,omitempty"`
+}
+
+func (g *GenesisContract) MarshalJSON() ([]byte, error) {
+	raw := &genesisContractRaw{
+		Address: g.Address,
+		Code:    hex.EncodeToHex(g.Code),
+		Storage: g.Storage,
+	}
+
+	if g.Balance != nil {
+		raw.Balance = types.EncodeBigInt(g.Balance)
+	}
+
+	return json.Marshal(raw)
+}
+
+func (g *GenesisContract) UnmarshalJSON(data []byte) error {
+	var (
+		raw genesisContractRaw
+		err error
+	)
+
+	if err = json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	g.Address = raw.Address
+	g.Storage = raw.Storage
+
+	g.Code, err = hex.DecodeHex(raw.Code)
+	if err != nil {
+		return fmt.Errorf("code: %w", err)
+	}
+
+	g.Balance, err = types.ParseUint256orHex(raw.Balance)
+	if err != nil {
+		return fmt.Errorf("balance: %w", err)
+	}
+
+	return nil
+}
+
+// Copy returns a deep copy of the GenesisContract
+func (g *GenesisContract) Copy() *GenesisContract {
+	cp := *g
+	cp.Balance = copyBigInt(g.Balance)
+
+	if g.Code != nil {
+		cp.Code = make([]byte, len(g.Code))
+		copy(cp.Code, g.Code)
+	}
+
+	if g.Storage != nil {
+		cp.Storage = make(map[types.Hash]types.Hash, len(g.Storage))
+		for key, value := range g.Storage {
+			cp.Storage[key] = value
+		}
+	}
+
+	return &cp
+}
+
+// Validate checks that the contract address is set and that the balance (if any) is not negative
+func (g *GenesisContract) Validate() error {
+	var err error
+
+	if g.Address == types.ZeroAddress {
+		err = multierror.Append(err, fieldErrorf("address", "address must not be zero address (address=%s)", g.Address))
+	}
+
+	if g.Balance != nil && g.Balance.Sign() < 0 {
+		err = multierror.Append(err, fieldErrorf("balance", "balance must be non-negative (balance=%s)", g.Balance))
+	}
+
+	return err
+}
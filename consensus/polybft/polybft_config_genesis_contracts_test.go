@@ -0,0 +1,55 @@
+package polybft
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPolyBFTConfig_GenesisContracts(t *testing.T) {
+	t.Parallel()
+
+	config := MinimalValidPolyBFTConfig()
+	require.False(t, config.HasGenesisContracts())
+
+	contract := &GenesisContract{
+		Address: types.StringToAddress("0x1010"),
+		Code:    []byte{0x60, 0x80, 0x60, 0x40},
+		Balance: big.NewInt(1000),
+		Storage: map[types.Hash]types.Hash{types.StringToHash("0x1"): types.StringToHash("0x2")},
+	}
+
+	config.GenesisContracts = []*GenesisContract{contract}
+	require.True(t, config.HasGenesisContracts())
+	require.NoError(t, config.Validate())
+
+	data, err := json.Marshal(config)
+	require.NoError(t, err)
+	require.Contains(t, string(data), `"address":"0x0000000000000000000000000000000000001010","code":"0x60806040",`+
+		`"balance":"0x3e8"`)
+
+	var decoded PolyBFTConfig
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	require.True(t, config.Equal(&decoded))
+	require.Equal(t, contract.Code, decoded.GenesisContracts[0].Code)
+
+	cp := config.Copy()
+	cp.GenesisContracts[0].Code[0] = 0
+	cp.GenesisContracts[0].Storage[types.StringToHash("0x1")] = types.ZeroHash
+	require.Equal(t, byte(0x60), contract.Code[0])
+	require.Equal(t, types.StringToHash("0x2"), contract.Storage[types.StringToHash("0x1")])
+	require.False(t, config.Equal(cp))
+
+	config.GenesisContracts = append(config.GenesisContracts,
+		&GenesisContract{Address: contract.Address}, &GenesisContract{Balance: big.NewInt(-1)})
+
+	err = config.Validate()
+	require.ErrorContains(t, err, "genesis contract address 0x0000000000000000000000000000000000001010 is duplicated")
+	require.ErrorContains(t, err, "genesisContracts[2]: 2 errors occurred")
+	require.ErrorContains(t, err, "balance must be non-negative (balance=-1)")
+
+	require.ErrorContains(t, json.Unmarshal([]byte(`{"code":"0xzz"}`), &GenesisContract{}), "code:")
+}
@@ -0,0 +1,132 @@
+package polybft
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/go-multierror"
+)
+
+const (
+	// SeverityError is the severity of the findings which render the config invalid (see PolyBFTConfig.Validate)
+	SeverityError = "error"
+	// SeverityWarning is the severity of the likely misconfigurations (see PolyBFTConfig.Warnings)
+	SeverityWarning = "warning"
+	// SeveritySuggestion is the severity of the valid settings which deviate from the recommended ones
+	SeveritySuggestion = "suggestion"
+
+	// minRecommendedEpochSize is the epoch size below which the epoch is considered unusually small
+	minRecommendedEpochSize = 10
+	// minFaultTolerantValidatorCount is the smallest validator set which tolerates a faulty validator (3f+1, f=1)
+	minFaultTolerantValidatorCount = 4
+)
+
+// Finding is a single issue of the config reported by PolyBFTConfig.Lint
+type Finding struct {
+	// Severity is one of SeverityError, SeverityWarning or SeveritySuggestion
+	Severity string `json:"severity"`
+	// Field is the JSON path of the offending field (e.g. bridge.checkpointInterval),
+	// or empty string if the finding can't be attributed to a single field
+	Field string `json:"field,omitempty"`
+	// Message describes the issue
+	Message string `json:"message"`
+}
+
+func (f Finding) String() string {
+	if f.Field == "" {
+		return fmt.Sprintf("%s: %s", f.Severity, f.Message)
+	}
+
+	return fmt.Sprintf("%s: %s: %s", f.Severity, f.Field, f.Message)
+}
+
+// Lint returns all the findings of the config: the validation errors, the warnings and the suggestions,
+// in this order. The fields are taken from the validation errors (see FieldError) and warnings (see ConfigWarning).
+// Errors of the nested configs (e.g. the bridge) are reported one by one, with the field prefixed
+// by the nested config name. The config is valid if none of the findings is an error.
+func (p *PolyBFTConfig) Lint() []Finding {
+	warnings, validationErr := SplitValidationWarnings(p.Validate())
+
+	var findings []Finding
+
+	var merr *multierror.Error
+	if errors.As(validationErr, &merr) {
+		findings = lintErrorFindings(merr, "")
+	}
+
+	for _, warning := range warnings {
+		findings = append(findings, Finding{
+			Severity: SeverityWarning,
+			Field:    warning.Field,
+			Message:  warning.Message,
+		})
+	}
+
+	return append(findings, p.suggestions()...)
+}
+
+// suggestions returns the findings of the valid settings which deviate from the recommended ones
+func (p *PolyBFTConfig) suggestions() []Finding {
+	var findings []Finding
+
+	if p.EpochSize > 0 && p.EpochSize < minRecommendedEpochSize {
+		findings = append(findings, Finding{
+			Severity: SeveritySuggestion,
+			Field:    "epochSize",
+			Message: fmt.Sprintf("epochSize is unusually small, which results in frequent validator set updates "+
+				"and checkpoints (epochSize=%d, recommended at least %d)", p.EpochSize, minRecommendedEpochSize),
+		})
+	}
+
+	if count := len(p.InitialValidatorSet); count > 0 && count < minFaultTolerantValidatorCount {
+		findings = append(findings, Finding{
+			Severity: SeveritySuggestion,
+			Field:    "initialValidatorSet",
+			Message: fmt.Sprintf("initialValidatorSet can't tolerate a single faulty validator "+
+				"(initialValidatorSet size=%d, recommended at least %d)", count, minFaultTolerantValidatorCount),
+		})
+	}
+
+	return findings
+}
+
+// lintErrorFindings converts the validation error into the findings, one finding per aggregated error.
+// FieldError of the nested config (e.g. the bridge), which aggregates the errors of its own fields,
+// is expanded into the findings of these fields, prefixed by the nested config field.
+// Errors which are not attributed to any field are reported with the parent field.
+func lintErrorFindings(err error, parent string) []Finding {
+	if merr, ok := err.(*multierror.Error); ok {
+		findings := make([]Finding, 0, len(merr.Errors))
+		for _, aggregatedErr := range merr.Errors {
+			findings = append(findings, lintErrorFindings(aggregatedErr, parent)...)
+		}
+
+		return findings
+	}
+
+	fieldErr, ok := err.(*FieldError)
+	if !ok {
+		return []Finding{{Severity: SeverityError, Field: parent, Message: err.Error()}}
+	}
+
+	field := joinLintField(parent, fieldErr.Field)
+
+	var nested *multierror.Error
+	if errors.As(fieldErr.Err, &nested) {
+		return lintErrorFindings(nested, field)
+	}
+
+	return []Finding{{Severity: SeverityError, Field: field, Message: err.Error()}}
+}
+
+// joinLintField joins the nested field name with the name of its parent
+func joinLintField(parent, field string) string {
+	switch {
+	case parent == "":
+		return field
+	case field == "":
+		return parent
+	default:
+		return parent + "." + field
+	}
+}
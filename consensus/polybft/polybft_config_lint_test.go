@@ -0,0 +1,280 @@
+package polybft
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/validator"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPolyBFTConfig_Lint(t *testing.T) {
+	t.Parallel()
+
+	findingsBySeverity := func(findings []Finding, severity string) []Finding {
+		var filtered []Finding
+
+		for _, finding := range findings {
+			if finding.Severity == severity {
+				filtered = append(filtered, finding)
+			}
+		}
+
+		return filtered
+	}
+
+	t.Run("minimal valid config has only suggestions", func(t *testing.T) {
+		t.Parallel()
+
+		config := MinimalValidPolyBFTConfig()
+
+		require.Equal(t, []Finding{{
+			Severity: SeveritySuggestion,
+			Field:    "initialValidatorSet",
+			Message: "initialValidatorSet can't tolerate a single faulty validator " +
+				"(initialValidatorSet size=1, recommended at least 4)",
+		}}, config.Lint())
+	})
+
+	t.Run("errors, warnings and suggestions", func(t *testing.T) {
+		t.Parallel()
+
+		config := MinimalValidPolyBFTConfig()
+		config.EpochSize = 4
+		config.SprintSize = 3
+		config.ExcludedValidators = []types.Address{types.StringToAddress("0xdead")}
+
+		findings := config.Lint()
+
+		require.Equal(t, []Finding{{
+			Severity: SeverityError,
+			Field:    "epochSize",
+			Message:  "epochSize must be divisible by sprintSize (epochSize=4, sprintSize=3)",
+		}}, findingsBySeverity(findings, SeverityError))
+
+		warnings := findingsBySeverity(findings, SeverityWarning)
+		require.Len(t, warnings, 1)
+		require.Empty(t, warnings[0].Field)
+		require.Contains(t, warnings[0].Message, "is not part of the initial validator set")
+
+		suggestions := findingsBySeverity(findings, SeveritySuggestion)
+		require.Len(t, suggestions, 2)
+		require.Equal(t, "epochSize", suggestions[0].Field)
+		require.Contains(t, suggestions[0].Message, "epochSize is unusually small")
+		require.Equal(t, "initialValidatorSet", suggestions[1].Field)
+
+		// errors come first, followed by the warnings and the suggestions
+		require.Equal(t, SeverityError, findings[0].Severity)
+		require.Equal(t, SeveritySuggestion, findings[len(findings)-1].Severity)
+	})
+
+	t.Run("nested errors are reported one by one", func(t *testing.T) {
+		t.Parallel()
+
+		config := MinimalValidPolyBFTConfig()
+		config.Bridge = &BridgeConfig{JSONRPCEndpoint: "http://127.0.0.1:8545", CheckpointInterval: 1}
+
+		errs := findingsBySeverity(config.Lint(), SeverityError)
+		require.Greater(t, len(errs), 1)
+		require.Contains(t, errs, Finding{
+			Severity: SeverityError,
+			Field:    "bridge.stateSenderAddress",
+			Message:  "stateSenderAddress must not be zero address",
+		})
+		require.Contains(t, errs, Finding{
+			Severity: SeverityError,
+			Field:    "bridge.maxBridgeBatchSize",
+			Message:  "maxBridgeBatchSize must be at least 1 (maxBridgeBatchSize=0)",
+		})
+		require.Contains(t, errs, Finding{
+			Severity: SeverityError,
+			Field:    "bridge.nativeERC20Address",
+			Message: "bridged native token requires rootchain native token " +
+				"(nativeERC20Address=0x0000000000000000000000000000000000000000)",
+		})
+	})
+
+	t.Run("fields of the indexed and nested entries", func(t *testing.T) {
+		t.Parallel()
+
+		bridge := newTestBridgeConfig()
+		bridge.CheckpointInterval = 0
+
+		config := MinimalValidPolyBFTConfig()
+		config.Bridges = map[uint64]*BridgeConfig{5: bridge}
+		config.GenesisContracts = []*GenesisContract{{Address: types.StringToAddress("0x1")}, {}}
+		config.RewardConfig = &RewardsConfig{RewardSource: "unknown"}
+
+		errs := findingsBySeverity(config.Lint(), SeverityError)
+		require.Contains(t, errs, Finding{
+			Severity: SeverityError,
+			Field:    "bridges[5].checkpointInterval",
+			Message:  "checkpointInterval must be at least 1 (checkpointInterval=0)",
+		})
+		require.Contains(t, errs, Finding{
+			Severity: SeverityError,
+			Field:    "genesisContracts[1].address",
+			Message:  "address must not be zero address (address=0x0000000000000000000000000000000000000000)",
+		})
+
+		var rewardSourceFound bool
+
+		for _, finding := range errs {
+			rewardSourceFound = rewardSourceFound || finding.Field == "rewardConfig.rewardSource"
+		}
+
+		require.True(t, rewardSourceFound)
+	})
+
+	t.Run("fields of the config-wide errors", func(t *testing.T) {
+		t.Parallel()
+
+		cases := []struct {
+			message string
+			field   string
+			modify  func(config *PolyBFTConfig)
+		}{
+			{
+				message: "has malformed BLS public key",
+				field:   "initialValidatorSet[0]",
+				modify: func(config *PolyBFTConfig) {
+					config.InitialValidatorSet[0].BlsKey = "0x01"
+				},
+			},
+			{
+				message: "have the same BLS public key",
+				field:   "initialValidatorSet[1]",
+				modify: func(config *PolyBFTConfig) {
+					config.InitialValidatorSet[0].BlsKey = "0x01"
+					config.InitialValidatorSet = append(config.InitialValidatorSet, &validator.GenesisValidator{
+						Address: types.StringToAddress("0x2"),
+						Balance: config.InitialValidatorSet[0].Balance,
+						Stake:   config.InitialValidatorSet[0].Stake,
+						BlsKey:  "0x01",
+					})
+				},
+			},
+			{
+				message: "all initial validators are excluded",
+				field:   "excludedValidators",
+				modify: func(config *PolyBFTConfig) {
+					config.ExcludedValidators = []types.Address{config.InitialValidatorSet[0].Address}
+				},
+			},
+			{
+				message: "is both in the allowList and the blockList",
+				field:   "allowList",
+				modify: func(config *PolyBFTConfig) {
+					config.AllowList = []types.Address{types.StringToAddress("0x5")}
+					config.BlockList = []types.Address{types.StringToAddress("0x5")}
+				},
+			},
+			{
+				message: "epoch reward must not exceed maxEpochReward",
+				field:   "maxEpochReward",
+				modify: func(config *PolyBFTConfig) {
+					config.EpochRewardWei = big.NewInt(2)
+					config.MaxEpochReward = big.NewInt(1)
+				},
+			},
+			{
+				message: "stake is below minValidatorStake",
+				field:   "initialValidatorSet[0]",
+				modify: func(config *PolyBFTConfig) {
+					config.MinValidatorStake = new(big.Int).Add(config.InitialValidatorSet[0].Stake, big.NewInt(1))
+				},
+			},
+			{
+				message: "genesis contract address 0x0000000000000000000000000000000000000001 is duplicated",
+				field:   "genesisContracts[1]",
+				modify: func(config *PolyBFTConfig) {
+					config.GenesisContracts = []*GenesisContract{
+						{Address: types.StringToAddress("0x1")}, {Address: types.StringToAddress("0x1")},
+					}
+				},
+			},
+			{
+				message: "doesn't match the declared total supply",
+				field:   "nativeTokenConfig",
+				modify: func(config *PolyBFTConfig) {
+					config.NativeTokenConfig.TotalSupply = big.NewInt(1)
+				},
+			},
+			{
+				message: "reward wallet can't fund a single epoch",
+				field:   "rewardConfig.rewardWalletAmount",
+				modify: func(config *PolyBFTConfig) {
+					config.EpochReward = 1
+					config.Governance = types.StringToAddress("0xb")
+					config.RewardConfig = &RewardsConfig{
+						WalletAddress: types.StringToAddress("0xa"),
+						WalletAmount:  big.NewInt(0),
+					}
+				},
+			},
+			{
+				message: "none of the JSON RPC endpoints is a valid ws, wss, http or https URL",
+				field:   "bridge.jsonRPCEndpoints",
+				modify: func(config *PolyBFTConfig) {
+					config.Bridge = newTestBridgeConfig()
+					config.Bridge.JSONRPCEndpoint = "ftp://127.0.0.1"
+				},
+			},
+		}
+
+		for _, c := range cases {
+			config := MinimalValidPolyBFTConfig()
+			c.modify(&config)
+
+			var found bool
+
+			for _, finding := range findingsBySeverity(config.Lint(), SeverityError) {
+				if strings.Contains(finding.Message, c.message) {
+					require.Equal(t, c.field, finding.Field, c.message)
+
+					found = true
+
+					break
+				}
+			}
+
+			require.True(t, found, c.message)
+		}
+	})
+
+	t.Run("fields of the warnings", func(t *testing.T) {
+		t.Parallel()
+
+		config := MinimalValidPolyBFTConfig()
+		config.Governance = config.InitialValidatorSet[0].Address
+
+		warnings := findingsBySeverity(config.Lint(), SeverityWarning)
+		require.Len(t, warnings, 1)
+		require.Equal(t, "governance", warnings[0].Field)
+	})
+}
+
+func TestPolyBFTConfig_ValidateFieldErrors(t *testing.T) {
+	t.Parallel()
+
+	config := MinimalValidPolyBFTConfig()
+	config.SprintSize = 0
+
+	var fieldErr *FieldError
+
+	err := config.Validate()
+	require.ErrorAs(t, err, &fieldErr)
+	require.Equal(t, "sprintSize", fieldErr.Field)
+	require.EqualError(t, fieldErr, "sprintSize must be greater than 0 (sprintSize=0)")
+}
+
+func TestFinding_String(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, "error: epochSize: epochSize must be greater than 0",
+		Finding{Severity: SeverityError, Field: "epochSize", Message: "epochSize must be greater than 0"}.String())
+	require.Equal(t, "warning: something is off",
+		Finding{Severity: SeverityWarning, Message: "something is off"}.String())
+}
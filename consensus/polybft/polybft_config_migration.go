@@ -0,0 +1,76 @@
+package polybft
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+const (
+	// CurrentPolyBFTConfigVersion is the version of the PolyBFTConfig shape produced by this code
+	CurrentPolyBFTConfigVersion uint = 1
+
+	configVersionField = "configVersion"
+)
+
+// configMigration transforms the JSON encoded config fields of one version into the shape of the next version
+type configMigration func(fields map[string]json.RawMessage) error
+
+// configMigrations holds the registered migrations, where the migration at index i
+// transforms the config of version i into version i+1
+var configMigrations = []configMigration{
+	// 0 -> 1: configs without the version have the same shape as the first versioned config
+	func(map[string]json.RawMessage) error { return nil },
+}
+
+// MigratePolyBFTConfig decodes the JSON encoded PolyBFTConfig of any supported version.
+// The version is read from the configVersion field (missing field means version 0) and the registered
+// migrations are applied in order, until the config is brought to the CurrentPolyBFTConfigVersion.
+func MigratePolyBFTConfig(raw []byte) (PolyBFTConfig, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &fields); err != nil {
+		return PolyBFTConfig{}, err
+	}
+
+	if fields == nil {
+		fields = map[string]json.RawMessage{}
+	}
+
+	var version uint
+
+	if versionJSON, ok := fields[configVersionField]; ok {
+		if err := json.Unmarshal(versionJSON, &version); err != nil {
+			return PolyBFTConfig{}, fmt.Errorf("invalid config version: %w", err)
+		}
+	}
+
+	if version > CurrentPolyBFTConfigVersion {
+		return PolyBFTConfig{}, fmt.Errorf("config version %d is newer than the supported version %d",
+			version, CurrentPolyBFTConfigVersion)
+	}
+
+	for ; version < CurrentPolyBFTConfigVersion; version++ {
+		if err := configMigrations[version](fields); err != nil {
+			return PolyBFTConfig{}, fmt.Errorf("failed to migrate config from version %d to %d: %w",
+				version, version+1, err)
+		}
+
+		versionJSON, err := json.Marshal(version + 1)
+		if err != nil {
+			return PolyBFTConfig{}, err
+		}
+
+		fields[configVersionField] = versionJSON
+	}
+
+	migrated, err := json.Marshal(fields)
+	if err != nil {
+		return PolyBFTConfig{}, err
+	}
+
+	var config PolyBFTConfig
+	if err := json.Unmarshal(migrated, &config); err != nil {
+		return PolyBFTConfig{}, err
+	}
+
+	return config, nil
+}
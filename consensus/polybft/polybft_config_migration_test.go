@@ -0,0 +1,55 @@
+package polybft
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigratePolyBFTConfig(t *testing.T) {
+	t.Parallel()
+
+	t.Run("unversioned config", func(t *testing.T) {
+		t.Parallel()
+
+		config, err := MigratePolyBFTConfig([]byte(`{"epochSize": 10, "sprintSize": 5, "blockTime": "2s"}`))
+		require.NoError(t, err)
+		require.Equal(t, CurrentPolyBFTConfigVersion, config.ConfigVersion)
+		require.Equal(t, uint64(10), config.EpochSize)
+		require.Equal(t, uint64(5), config.SprintSize)
+	})
+
+	t.Run("current version round trip", func(t *testing.T) {
+		t.Parallel()
+
+		original := DefaultPolyBFTConfig()
+
+		data, err := json.Marshal(original)
+		require.NoError(t, err)
+
+		config, err := MigratePolyBFTConfig(data)
+		require.NoError(t, err)
+		require.Equal(t, *original, config)
+	})
+
+	t.Run("newer version", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := MigratePolyBFTConfig([]byte(`{"configVersion": 1000}`))
+		require.ErrorContains(t, err, "config version 1000 is newer than the supported version")
+	})
+
+	t.Run("invalid version", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := MigratePolyBFTConfig([]byte(`{"configVersion": "one"}`))
+		require.ErrorContains(t, err, "invalid config version")
+	})
+
+	t.Run("migrations are registered for every version", func(t *testing.T) {
+		t.Parallel()
+
+		require.Len(t, configMigrations, int(CurrentPolyBFTConfigVersion))
+	})
+}
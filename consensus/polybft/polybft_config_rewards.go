@@ -0,0 +1,496 @@
+package polybft
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/contracts"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/go-hclog"
+)
+
+type RewardsConfig struct {
+	// TokenAddress is the address of reward token on child chain
+	TokenAddress types.Address
+
+	// WalletAddress is the address of reward wallet on child chain
+	WalletAddress types.Address
+
+	// WalletAmount is the amount of tokens in reward wallet. In JSON, the plain (hex or decimal integer) amount
+	// is in base units, while the amount with the token symbol suffix (e.g. "1000.5 MIND", see ParseTokenAmount)
+	// is in tokens, scaled by the native token decimals (or defaultNativeTokenDecimals if there is no native
+	// token config, e.g. when RewardsConfig is decoded on its own).
+	// The missing (or null) JSON amount is decoded as zero, whereas nil amount is the unlimited wallet amount
+	// (RewardWalletAmountUnlimited in JSON), see IsUnlimited.
+	WalletAmount *big.Int
+
+	// InflationRate is the optional annual inflation rate of the total stake, expressed in basis points
+	InflationRate *big.Int
+
+	// RewardSource is the source the validators rewards are paid from
+	// (RewardSourceInflation, RewardSourceFees or RewardSourceHybrid). Defaults to RewardSourceInflation.
+	RewardSource string
+
+	// RewardDistribution is the way the epoch reward is split among the validators (RewardDistributionEqual,
+	// RewardDistributionStakeWeighted or RewardDistributionUptimeWeighted). Defaults to RewardDistributionEqual.
+	RewardDistribution string
+}
+
+// ValidatorReward carries the validator properties the epoch reward is distributed by, see DistributeReward
+type ValidatorReward struct {
+	Address types.Address
+	// Stake is the stake of the validator
+	Stake *big.Int
+	// Uptime is the validator uptime in the epoch (e.g. the number of blocks signed by the validator)
+	Uptime uint64
+}
+
+// EffectiveRewardSource returns the configured reward source, defaulting to RewardSourceInflation
+func (r *RewardsConfig) EffectiveRewardSource() string {
+	if r.RewardSource == "" {
+		return RewardSourceInflation
+	}
+
+	return r.RewardSource
+}
+
+// EffectiveRewardDistribution returns the configured reward distribution, defaulting to RewardDistributionEqual
+func (r *RewardsConfig) EffectiveRewardDistribution() string {
+	if r.RewardDistribution == "" {
+		return RewardDistributionEqual
+	}
+
+	return r.RewardDistribution
+}
+
+// RequiresFundedWallet indicates whether the rewards are paid (at least partially) from the reward wallet,
+// i.e. whether the reward wallet must be configured and funded at genesis
+func (r *RewardsConfig) RequiresFundedWallet() bool {
+	return r.EffectiveRewardSource() != RewardSourceFees
+}
+
+// ComputeReward blends the inflation component (the epoch reward paid from the reward wallet)
+// and the fee component (the validators share of the prior epoch transaction fees) according to
+// the reward source: inflation uses only the inflation component, fees only the fee component
+// and hybrid the sum of both. Nil components are treated as zero.
+func (r *RewardsConfig) ComputeReward(inflationComponent, feeComponent *big.Int) *big.Int {
+	reward := big.NewInt(0)
+
+	switch r.EffectiveRewardSource() {
+	case RewardSourceInflation:
+		if inflationComponent != nil {
+			reward.Set(inflationComponent)
+		}
+	case RewardSourceFees:
+		if feeComponent != nil {
+			reward.Set(feeComponent)
+		}
+	case RewardSourceHybrid:
+		if inflationComponent != nil {
+			reward.Add(reward, inflationComponent)
+		}
+
+		if feeComponent != nil {
+			reward.Add(reward, feeComponent)
+		}
+	}
+
+	return reward
+}
+
+// DistributeReward splits the total reward among the validators according to the reward distribution.
+// Each validator gets the share of the total proportional to its weight (1 for the equal distribution,
+// its stake or its uptime for the weighted ones), rounded down. The rounding remainder is assigned one unit
+// at a time to the validators with the largest truncated fractions (ties are broken by the lower address),
+// so that the distributed amounts always sum up to the total. If all the weights are zero,
+// the reward is split equally. Non-positive (or nil) total results in zero rewards.
+func (r *RewardsConfig) DistributeReward(total *big.Int, validators []ValidatorReward) map[types.Address]*big.Int {
+	rewards := make(map[types.Address]*big.Int, len(validators))
+	for _, v := range validators {
+		rewards[v.Address] = big.NewInt(0)
+	}
+
+	if len(validators) == 0 || total == nil || total.Sign() <= 0 {
+		return rewards
+	}
+
+	weights := make([]*big.Int, len(validators))
+	totalWeight := big.NewInt(0)
+
+	for i, v := range validators {
+		weight := big.NewInt(1)
+
+		switch r.EffectiveRewardDistribution() {
+		case RewardDistributionStakeWeighted:
+			weight.SetInt64(0)
+
+			if v.Stake != nil && v.Stake.Sign() > 0 {
+				weight.Set(v.Stake)
+			}
+		case RewardDistributionUptimeWeighted:
+			weight.SetUint64(v.Uptime)
+		}
+
+		weights[i] = weight
+		totalWeight.Add(totalWeight, weight)
+	}
+
+	if totalWeight.Sign() == 0 {
+		for i := range weights {
+			weights[i] = big.NewInt(1)
+		}
+
+		totalWeight.SetInt64(int64(len(weights)))
+	}
+
+	fractions := make([]*big.Int, len(validators))
+	remainder := new(big.Int).Set(total)
+
+	for i, v := range validators {
+		share, fraction := new(big.Int).QuoRem(new(big.Int).Mul(total, weights[i]), totalWeight, new(big.Int))
+		fractions[i] = fraction
+
+		rewards[v.Address].Add(rewards[v.Address], share)
+		remainder.Sub(remainder, share)
+	}
+
+	order := make([]int, len(validators))
+	for i := range order {
+		order[i] = i
+	}
+
+	sort.SliceStable(order, func(i, j int) bool {
+		if c := fractions[order[i]].Cmp(fractions[order[j]]); c != 0 {
+			return c > 0
+		}
+
+		return bytes.Compare(validators[order[i]].Address[:], validators[order[j]].Address[:]) < 0
+	})
+
+	// the remainder is less than the number of validators, since each of the fractions is less than one unit
+	for i := 0; remainder.Sign() > 0; i++ {
+		addr := validators[order[i]].Address
+		rewards[addr].Add(rewards[addr], big.NewInt(1))
+		remainder.Sub(remainder, big.NewInt(1))
+	}
+
+	return rewards
+}
+
+// IsUnlimited returns true if the reward wallet amount is not capped by the config (nil WalletAmount),
+// which is allowed only for the mintable native token. No tokens are minted to such a wallet at genesis,
+// while the RewardPool is approved to spend all of the wallet tokens. Note that minting the reward tokens
+// on demand is out of scope of the config: the rewards are paid only as long as the wallet holds the tokens,
+// which are minted to it by the native token minter.
+func (r *RewardsConfig) IsUnlimited() bool {
+	return r.WalletAmount == nil
+}
+
+// EffectiveEpochReward returns the reward per epoch derived from the annual inflation of the total stake.
+// The rewards config carries no fixed reward per epoch (WalletAmount is the wallet balance), hence zero is returned
+// if InflationRate is not set. The reward per epoch of the chain, which falls back to the configured epoch reward
+// in that case, is PolyBFTConfig.EpochRewardFor.
+func (r *RewardsConfig) EffectiveEpochReward(totalStake *big.Int, epochsPerYear uint64) *big.Int {
+	if r.InflationRate == nil || totalStake == nil || epochsPerYear == 0 {
+		return big.NewInt(0)
+	}
+
+	reward := new(big.Int).Mul(totalStake, r.InflationRate)
+	reward.Div(reward, big.NewInt(maxBasisPoints))
+
+	return reward.Div(reward, new(big.Int).SetUint64(epochsPerYear))
+}
+
+// String implements fmt.Stringer interface.
+// Wallet amount is printed both in wei and in tokens, assuming 18 decimals.
+func (r *RewardsConfig) String() string {
+	walletAmount := RewardWalletAmountUnlimited
+	if !r.IsUnlimited() {
+		walletAmount = fmt.Sprintf("%s wei (~%s tokens)", r.WalletAmount, weiToTokens(r.WalletAmount, maxTokenDecimals))
+	}
+
+	return fmt.Sprintf("Token=%s; Wallet=%s; Wallet amount=%s;", r.TokenAddress, r.WalletAddress, walletAmount)
+}
+
+// Copy returns a deep copy of the RewardsConfig
+func (r *RewardsConfig) Copy() *RewardsConfig {
+	cp := *r
+	cp.WalletAmount = copyBigInt(r.WalletAmount)
+	cp.InflationRate = copyBigInt(r.InflationRate)
+
+	return &cp
+}
+
+func (r *RewardsConfig) MarshalJSON() ([]byte, error) {
+	return json.Marshal(r.toRaw())
+}
+
+func (r *RewardsConfig) UnmarshalJSON(data []byte) error {
+	var raw rewardsConfigRaw
+
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	return r.fromRaw(&raw, defaultNativeTokenDecimals)
+}
+
+// toRaw converts the rewards config to its JSON representation, with the wallet amount in base units
+func (r *RewardsConfig) toRaw() *rewardsConfigRaw {
+	walletAmount := RewardWalletAmountUnlimited
+	if !r.IsUnlimited() {
+		walletAmount = *types.EncodeBigInt(r.WalletAmount)
+	}
+
+	raw := &rewardsConfigRaw{
+		TokenAddress:       r.TokenAddress,
+		WalletAddress:      r.WalletAddress,
+		WalletAmount:       &walletAmount,
+		RewardSource:       r.RewardSource,
+		RewardDistribution: r.RewardDistribution,
+	}
+
+	if r.InflationRate != nil {
+		raw.InflationRate = types.EncodeBigInt(r.InflationRate)
+	}
+
+	return raw
+}
+
+// fromRaw sets the rewards config from its JSON representation.
+// The wallet amount given in tokens is scaled to the base units using the given native token decimals.
+func (r *RewardsConfig) fromRaw(raw *rewardsConfigRaw, decimals uint8) (err error) {
+	r.TokenAddress = raw.TokenAddress
+	r.WalletAddress = raw.WalletAddress
+	r.RewardSource = raw.RewardSource
+	r.RewardDistribution = raw.RewardDistribution
+
+	if raw.WalletAmount != nil && strings.EqualFold(strings.TrimSpace(*raw.WalletAmount), RewardWalletAmountUnlimited) {
+		r.WalletAmount = nil
+	} else {
+		r.WalletAmount, err = parseRewardAmount(raw.WalletAmount, decimals)
+		if err != nil {
+			return err
+		}
+
+		// missing (or null) wallet amount is treated as zero
+		if r.WalletAmount == nil {
+			r.WalletAmount = big.NewInt(0)
+		}
+	}
+
+	r.InflationRate, err = types.ParseUint256orHex(raw.InflationRate)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+type rewardsConfigRaw struct {
+	TokenAddress       types.Address `json:"rewardTokenAddress"`
+	WalletAddress      types.Address `json:"rewardWalletAddress"`
+	WalletAmount       *string       `json:"rewardWalletAmount"`
+	InflationRate      *string       `json:"rewardInflationRate,omitempty"`
+	RewardSource       string        `json:"rewardSource,omitempty"`
+	RewardDistribution string        `json:"rewardDistribution,omitempty"`
+}
+
+// parseRewardAmount parses the reward amount, which is either in base units (hex or decimal integer),
+// or in tokens with the token symbol suffix (e.g. "10.5 MIND"), in the format accepted by ParseTokenAmount.
+// The symbol suffix is what tells the tokens from the base units, so the amounts without it
+// which aren't plain integers (e.g. "10.5" or "1e6") are rejected as ambiguous.
+func parseRewardAmount(raw *string, decimals uint8) (*big.Int, error) {
+	if raw == nil || len(strings.Fields(*raw)) < 2 {
+		amount, err := types.ParseUint256orHex(raw)
+		if err != nil {
+			return nil, fmt.Errorf("invalid reward amount %q, expected either integer amount in base units "+
+				"or amount in tokens with the token symbol (e.g. \"10.5 MIND\")", *raw)
+		}
+
+		return amount, nil
+	}
+
+	return ParseTokenAmount(*raw, decimals)
+}
+
+// RewardsEnabled is the authoritative answer whether validators are rewarded for blocks sealing.
+// Rewards are enabled only if RewardConfig is set (i.e. there is a reward wallet to pay rewards from)
+// and either the inflation rate (see RewardsConfig.InflationRate) or, without the inflation rate,
+// the epoch reward (see EpochRewardAmount) is greater than zero. Non-zero epoch reward with nil RewardConfig
+// as well as zero epoch reward and inflation rate with RewardConfig set are all considered as disabled rewards.
+func (p *PolyBFTConfig) RewardsEnabled() bool {
+	if p.RewardConfig == nil {
+		return false
+	}
+
+	if p.RewardConfig.InflationRate != nil {
+		return p.RewardConfig.InflationRate.Sign() > 0
+	}
+
+	reward, _ := p.cappedEpochReward()
+
+	return reward.Sign() > 0
+}
+
+// RewardPoolAddress returns the address of the reward pool system contract, which distributes the epoch rewards.
+// The address is the fixed child chain system address the consensus engine commits the rewards to,
+// it doesn't depend on the config, but it is exposed here so that the tooling doesn't hard-code it.
+func (p *PolyBFTConfig) RewardPoolAddress() types.Address {
+	return contracts.RewardPoolContract
+}
+
+// EpochRewardAmount returns the reward assigned to validators for blocks sealing per epoch.
+// EpochRewardWei takes precedence if present, otherwise EpochReward is used.
+// The reward is capped by MaxEpochReward (if set), in which case a warning is logged by the given logger.
+func (p *PolyBFTConfig) EpochRewardAmount(logger hclog.Logger) *big.Int {
+	reward, capped := p.cappedEpochReward()
+	if capped {
+		logger.Warn("epoch reward exceeds the maximum epoch reward, capping it",
+			"epochReward", p.configuredEpochReward(), "maxEpochReward", p.MaxEpochReward)
+	}
+
+	return reward
+}
+
+// cappedEpochReward returns the configured epoch reward capped by MaxEpochReward,
+// and whether the cap was applied
+func (p *PolyBFTConfig) cappedEpochReward() (*big.Int, bool) {
+	reward := p.configuredEpochReward()
+
+	if p.MaxEpochReward != nil && reward.Cmp(p.MaxEpochReward) > 0 {
+		return new(big.Int).Set(p.MaxEpochReward), true
+	}
+
+	return reward, false
+}
+
+// EpochRewardFor returns the reward per epoch for the given total stake, which is derived from the annual
+// inflation of the total stake if the inflation rate is configured (see RewardsConfig.EffectiveEpochReward),
+// or the configured epoch reward capped by MaxEpochReward otherwise
+func (p *PolyBFTConfig) EpochRewardFor(totalStake *big.Int, epochsPerYear uint64) *big.Int {
+	if p.RewardConfig != nil && p.RewardConfig.InflationRate != nil {
+		return p.RewardConfig.EffectiveEpochReward(totalStake, epochsPerYear)
+	}
+
+	reward, _ := p.cappedEpochReward()
+
+	return reward
+}
+
+// GenesisEpochReward returns the base reward per epoch the RewardPool is initialized with at genesis.
+// The inflation based reward is derived from the total stake of the initial active validators, since the base
+// reward is not updated afterwards. Otherwise it is EpochRewardAmount, whose capping is logged by the given logger.
+func (p *PolyBFTConfig) GenesisEpochReward(logger hclog.Logger) *big.Int {
+	if p.RewardConfig == nil || p.RewardConfig.InflationRate == nil {
+		return p.EpochRewardAmount(logger)
+	}
+
+	epochsPerYear, err := p.epochsPerYear()
+	if err != nil {
+		logger.Warn("failed to derive the inflation based epoch reward, no reward is paid", "err", err)
+
+		return big.NewInt(0)
+	}
+
+	totalStake := big.NewInt(0)
+
+	for _, v := range p.ActiveValidators() {
+		if v.Stake != nil {
+			totalStake.Add(totalStake, v.Stake)
+		}
+	}
+
+	return p.EpochRewardFor(totalStake, epochsPerYear)
+}
+
+// epochsPerYear returns the number of epochs in a year, derived from the sprints per epoch and the BlockTime
+func (p *PolyBFTConfig) epochsPerYear() (uint64, error) {
+	sprintsPerEpoch, err := p.SprintsPerEpoch()
+	if err != nil {
+		return 0, err
+	}
+
+	epochDuration := time.Duration(sprintsPerEpoch*p.SprintSize) * p.BlockTime.Duration
+	if epochDuration <= 0 {
+		return 0, fmt.Errorf("epoch duration must be greater than 0 (epochSize=%d, blockTime=%s)",
+			p.EpochSize, p.BlockTime.Duration)
+	}
+
+	return uint64(yearDuration / epochDuration), nil
+}
+
+// EpochsFundedByRewardWallet returns the number of epochs the reward wallet amount covers,
+// assuming the fixed epoch reward (see EpochRewardAmount) is paid out each epoch.
+// It returns an error if the wallet can't fund even a single epoch.
+func (p *PolyBFTConfig) EpochsFundedByRewardWallet() (uint64, error) {
+	if p.RewardConfig == nil {
+		return 0, errors.New("rewards config is missing, hence there is no reward wallet")
+	}
+
+	epochReward, _ := p.cappedEpochReward()
+	if epochReward.Sign() <= 0 {
+		return 0, fmt.Errorf("epoch reward must be greater than 0 to compute the epochs funded by the reward wallet "+
+			"(epochReward=%s)", epochReward)
+	}
+
+	if p.RewardConfig.IsUnlimited() {
+		// the funds of the unlimited reward wallet are not known to the config
+		return math.MaxUint64, nil
+	}
+
+	walletAmount := p.RewardConfig.WalletAmount
+
+	epochs := new(big.Int).Div(walletAmount, epochReward)
+	if epochs.Sign() <= 0 {
+		return 0, fmt.Errorf("reward wallet can't fund a single epoch "+
+			"(rewardWalletAmount=%s, epochReward=%s)", walletAmount, epochReward)
+	}
+
+	if !epochs.IsUint64() {
+		return math.MaxUint64, nil
+	}
+
+	return epochs.Uint64(), nil
+}
+
+// configuredEpochReward returns the configured epoch reward, without applying MaxEpochReward
+func (p *PolyBFTConfig) configuredEpochReward() *big.Int {
+	if p.EpochRewardWei != nil {
+		return new(big.Int).Set(p.EpochRewardWei)
+	}
+
+	return new(big.Int).SetUint64(p.EpochReward)
+}
+
+// ProjectedAnnualReward estimates the reward the validator with the given stake earns in a year.
+// Epoch duration is derived from the sprints per epoch and the BlockTime. Reward per epoch is either
+// the fixed epoch reward, or, if the inflation rate is configured, the annual inflation of the total stake
+// spread across the epochs. The validator earns a share of the epoch reward proportional to its stake.
+func (p *PolyBFTConfig) ProjectedAnnualReward(validatorStake, totalStake *big.Int) (*big.Int, error) {
+	if totalStake == nil || totalStake.Sign() <= 0 {
+		return nil, fmt.Errorf("total stake must be greater than 0 (totalStake=%v)", totalStake)
+	}
+
+	if validatorStake == nil || validatorStake.Sign() < 0 || validatorStake.Cmp(totalStake) > 0 {
+		return nil, fmt.Errorf("validator stake must be between 0 and total stake (validatorStake=%v, totalStake=%s)",
+			validatorStake, totalStake)
+	}
+
+	epochsPerYear, err := p.epochsPerYear()
+	if err != nil {
+		return nil, err
+	}
+
+	reward := new(big.Int).Mul(p.EpochRewardFor(totalStake, epochsPerYear), new(big.Int).SetUint64(epochsPerYear))
+	reward.Mul(reward, validatorStake)
+
+	return reward.Div(reward, totalStake), nil
+}
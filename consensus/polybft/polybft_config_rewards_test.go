@@ -0,0 +1,711 @@
+package polybft
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/big"
+	mrand "math/rand"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/contracts"
+	"github.com/0xPolygon/polygon-edge/helper/common"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRewardsConfig_String(t *testing.T) {
+	t.Parallel()
+
+	amount, ok := new(big.Int).SetString("1500000000000000000", 10)
+	require.True(t, ok)
+
+	config := &RewardsConfig{WalletAmount: amount}
+	require.Contains(t, config.String(), "Wallet amount=1500000000000000000 wei (~1.5000 tokens);")
+}
+
+func TestRewardsConfig_EffectiveEpochReward(t *testing.T) {
+	t.Parallel()
+
+	totalStake := big.NewInt(1_000_000)
+
+	t.Run("no inflation rate", func(t *testing.T) {
+		t.Parallel()
+
+		config := &RewardsConfig{WalletAmount: big.NewInt(500)}
+		require.Zero(t, config.EffectiveEpochReward(totalStake, 100).Sign())
+		require.Zero(t, (&RewardsConfig{}).EffectiveEpochReward(totalStake, 100).Sign())
+	})
+
+	t.Run("config falls back to the epoch reward", func(t *testing.T) {
+		t.Parallel()
+
+		config := MinimalValidPolyBFTConfig()
+		config.EpochReward = 7
+		config.RewardConfig = &RewardsConfig{WalletAmount: big.NewInt(1_000_000)}
+		require.Equal(t, big.NewInt(7), config.EpochRewardFor(totalStake, 100))
+
+		config.RewardConfig.InflationRate = big.NewInt(maxBasisPoints)
+		require.Equal(t, big.NewInt(10_000), config.EpochRewardFor(totalStake, 100))
+	})
+
+	t.Run("zero rate", func(t *testing.T) {
+		t.Parallel()
+
+		config := &RewardsConfig{WalletAmount: big.NewInt(500), InflationRate: big.NewInt(0)}
+		require.Zero(t, config.EffectiveEpochReward(totalStake, 100).Sign())
+	})
+
+	t.Run("max rate", func(t *testing.T) {
+		t.Parallel()
+
+		config := &RewardsConfig{InflationRate: big.NewInt(maxBasisPoints)}
+		require.Equal(t, big.NewInt(10_000), config.EffectiveEpochReward(totalStake, 100))
+	})
+
+	t.Run("no epochs per year", func(t *testing.T) {
+		t.Parallel()
+
+		config := &RewardsConfig{InflationRate: big.NewInt(500)}
+		require.Zero(t, config.EffectiveEpochReward(totalStake, 0).Sign())
+	})
+
+	t.Run("JSON round trip", func(t *testing.T) {
+		t.Parallel()
+
+		original := &RewardsConfig{WalletAmount: big.NewInt(1000), InflationRate: big.NewInt(500)}
+
+		data, err := json.Marshal(original)
+		require.NoError(t, err)
+		require.Contains(t, string(data), `"rewardInflationRate":"0x1f4"`)
+
+		decoded := &RewardsConfig{}
+		require.NoError(t, json.Unmarshal(data, decoded))
+		require.Equal(t, original, decoded)
+	})
+
+	t.Run("rate out of range", func(t *testing.T) {
+		t.Parallel()
+
+		config := &PolyBFTConfig{RewardConfig: &RewardsConfig{InflationRate: big.NewInt(maxBasisPoints + 1)}}
+		require.ErrorContains(t, config.Validate(), "rewardInflationRate must be between 0 and 10000 basis points")
+	})
+}
+
+func TestRewardsConfig_JSONRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	maxAmount := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+	amounts := []*big.Int{nil, big.NewInt(0), big.NewInt(1), maxAmount}
+
+	for i := 0; i < 50; i++ {
+		amounts = append(amounts, new(big.Int).Rand(mrand.New(mrand.NewSource(int64(i))), maxAmount))
+	}
+
+	for i, amount := range amounts {
+		addrBytes := make([]byte, types.AddressLength)
+		_, err := mrand.New(mrand.NewSource(int64(i))).Read(addrBytes)
+		require.NoError(t, err)
+
+		original := &RewardsConfig{
+			TokenAddress:  types.BytesToAddress(addrBytes),
+			WalletAddress: types.BytesToAddress(addrBytes[1:]),
+			WalletAmount:  amount,
+		}
+
+		data, err := json.Marshal(original)
+		require.NoError(t, err)
+
+		decoded := &RewardsConfig{}
+		require.NoError(t, json.Unmarshal(data, decoded))
+
+		require.Equal(t, original.TokenAddress, decoded.TokenAddress)
+		require.Equal(t, original.WalletAddress, decoded.WalletAddress)
+
+		// nil amount is the unlimited one
+		require.Equal(t, original.IsUnlimited(), decoded.IsUnlimited(), "amount %v", amount)
+		require.True(t, original.Equal(decoded), "amount %v", amount)
+
+		// encoding is symmetric from the first round trip onwards
+		reencoded, err := json.Marshal(decoded)
+		require.NoError(t, err)
+		require.Equal(t, data, reencoded)
+	}
+}
+
+func TestRewardsConfig_UnmarshalMissingWalletAmount(t *testing.T) {
+	t.Parallel()
+
+	for _, data := range []string{`{}`, `{"rewardWalletAmount": null}`} {
+		config := &RewardsConfig{}
+		require.NoError(t, json.Unmarshal([]byte(data), config))
+		require.NotNil(t, config.WalletAmount)
+		require.Zero(t, config.WalletAmount.Sign())
+	}
+}
+
+func TestRewardsConfig_IsUnlimited(t *testing.T) {
+	t.Parallel()
+
+	var rewards RewardsConfig
+	require.NoError(t, json.Unmarshal([]byte(`{"rewardWalletAmount": "Unlimited"}`), &rewards))
+	require.True(t, rewards.IsUnlimited())
+	require.Nil(t, rewards.WalletAmount)
+	require.Contains(t, rewards.String(), "Wallet amount=unlimited")
+
+	data, err := json.Marshal(&rewards)
+	require.NoError(t, err)
+	require.Contains(t, string(data), `"rewardWalletAmount":"unlimited"`)
+
+	// missing and null amounts are still zero
+	for _, input := range []string{`{}`, `{"rewardWalletAmount": null}`} {
+		require.NoError(t, json.Unmarshal([]byte(input), &rewards))
+		require.False(t, rewards.IsUnlimited(), input)
+		require.Equal(t, big.NewInt(0), rewards.WalletAmount, input)
+	}
+
+	// nil amount of the programmatic config is the unlimited one
+	data, err = json.Marshal(&RewardsConfig{})
+	require.NoError(t, err)
+	require.Contains(t, string(data), `"rewardWalletAmount":"unlimited"`)
+	require.NoError(t, json.Unmarshal(data, &rewards))
+	require.True(t, rewards.IsUnlimited())
+	require.True(t, rewards.Equal(&RewardsConfig{}))
+	require.False(t, rewards.Equal(&RewardsConfig{WalletAmount: big.NewInt(0)}))
+
+	config := MinimalValidPolyBFTConfig()
+	config.EpochReward = 1
+	config.Governance = types.StringToAddress("0xb")
+	config.RewardConfig = &RewardsConfig{WalletAddress: types.StringToAddress("0xa")}
+	require.ErrorContains(t, config.Validate(), "unlimited rewardWalletAmount is allowed only for mintable native token")
+
+	config.NativeTokenConfig.IsMintable = true
+	require.NoError(t, config.Validate())
+
+	epochs, err := config.EpochsFundedByRewardWallet()
+	require.NoError(t, err)
+	require.Equal(t, uint64(math.MaxUint64), epochs)
+}
+
+func TestRewardsConfig_ComputeReward(t *testing.T) {
+	t.Parallel()
+
+	inflation, fees := big.NewInt(100), big.NewInt(30)
+
+	cases := []struct {
+		source   string
+		expected *big.Int
+	}{
+		{"", big.NewInt(100)},
+		{RewardSourceInflation, big.NewInt(100)},
+		{RewardSourceFees, big.NewInt(30)},
+		{RewardSourceHybrid, big.NewInt(130)},
+	}
+
+	for _, c := range cases {
+		config := &RewardsConfig{RewardSource: c.source}
+		require.Equal(t, c.expected, config.ComputeReward(inflation, fees), c.source)
+		require.Equal(t, big.NewInt(0), config.ComputeReward(nil, nil), c.source)
+	}
+
+	// components are not modified
+	require.Equal(t, big.NewInt(100), inflation)
+	require.Equal(t, big.NewInt(30), fees)
+}
+
+func TestRewardsConfig_DistributeReward(t *testing.T) {
+	t.Parallel()
+
+	addr1, addr2, addr3 := types.StringToAddress("1"), types.StringToAddress("2"), types.StringToAddress("3")
+	validators := []ValidatorReward{
+		{Address: addr3, Stake: big.NewInt(100), Uptime: 10},
+		{Address: addr1, Stake: big.NewInt(300), Uptime: 10},
+		{Address: addr2, Stake: big.NewInt(600), Uptime: 30},
+	}
+
+	cases := []struct {
+		distribution string
+		total        int64
+		expected     map[types.Address]int64
+	}{
+		// the remainder goes to the lowest address in case of the equal fractions
+		{"", 100, map[types.Address]int64{addr1: 34, addr2: 33, addr3: 33}},
+		{RewardDistributionEqual, 101, map[types.Address]int64{addr1: 34, addr2: 34, addr3: 33}},
+		{RewardDistributionStakeWeighted, 1000, map[types.Address]int64{addr1: 300, addr2: 600, addr3: 100}},
+		// 2.7, 5.4 and 0.9 are rounded to 3, 5 and 1, since 0.9 and 0.7 are the largest truncated fractions
+		{RewardDistributionStakeWeighted, 9, map[types.Address]int64{addr1: 3, addr2: 5, addr3: 1}},
+		{RewardDistributionUptimeWeighted, 50, map[types.Address]int64{addr1: 10, addr2: 30, addr3: 10}},
+		// 1.4, 4.2 and 1.4 are rounded to 2, 4 and 1, since the lower address wins the tie
+		{RewardDistributionUptimeWeighted, 7, map[types.Address]int64{addr1: 2, addr2: 4, addr3: 1}},
+	}
+
+	for _, c := range cases {
+		config := &RewardsConfig{RewardDistribution: c.distribution}
+		rewards := config.DistributeReward(big.NewInt(c.total), validators)
+
+		expected := make(map[types.Address]*big.Int, len(c.expected))
+		for addr, reward := range c.expected {
+			expected[addr] = big.NewInt(reward)
+		}
+
+		require.Equal(t, expected, rewards, "%s %d", c.distribution, c.total)
+	}
+
+	// zero weights fall back to the equal distribution
+	rewards := (&RewardsConfig{RewardDistribution: RewardDistributionStakeWeighted}).DistributeReward(
+		big.NewInt(10), []ValidatorReward{{Address: addr1}, {Address: addr2}})
+	require.Equal(t, map[types.Address]*big.Int{addr1: big.NewInt(5), addr2: big.NewInt(5)}, rewards)
+
+	// nothing to distribute
+	require.Empty(t, (&RewardsConfig{}).DistributeReward(big.NewInt(10), nil))
+	require.Equal(t, map[types.Address]*big.Int{addr1: big.NewInt(0)},
+		(&RewardsConfig{}).DistributeReward(nil, []ValidatorReward{{Address: addr1}}))
+}
+
+func TestRewardsConfig_DistributeRewardSum(t *testing.T) {
+	t.Parallel()
+
+	rnd := mrand.New(mrand.NewSource(1)) //nolint:gosec
+
+	for i := 0; i < 200; i++ {
+		validators := make([]ValidatorReward, 1+rnd.Intn(20))
+		for j := range validators {
+			validators[j] = ValidatorReward{
+				Address: types.StringToAddress(fmt.Sprint(j + 1)),
+				Stake:   big.NewInt(rnd.Int63n(1_000_000)),
+				Uptime:  uint64(rnd.Intn(100)),
+			}
+		}
+
+		total := big.NewInt(rnd.Int63n(1_000_000_000))
+
+		for _, distribution := range []string{
+			RewardDistributionEqual, RewardDistributionStakeWeighted, RewardDistributionUptimeWeighted,
+		} {
+			rewards := (&RewardsConfig{RewardDistribution: distribution}).DistributeReward(total, validators)
+			require.Len(t, rewards, len(validators))
+
+			sum := big.NewInt(0)
+			for _, reward := range rewards {
+				require.True(t, reward.Sign() >= 0)
+				sum.Add(sum, reward)
+			}
+
+			require.Equal(t, total, sum, distribution)
+		}
+	}
+}
+
+func TestRewardsConfig_RewardSourceJSON(t *testing.T) {
+	t.Parallel()
+
+	config := &RewardsConfig{WalletAmount: big.NewInt(0), RewardSource: RewardSourceHybrid}
+
+	data, err := json.Marshal(config)
+	require.NoError(t, err)
+	require.Contains(t, string(data), `"rewardSource":"hybrid"`)
+
+	decoded := &RewardsConfig{}
+	require.NoError(t, json.Unmarshal(data, decoded))
+	require.Equal(t, RewardSourceHybrid, decoded.RewardSource)
+
+	// omitted reward source is the same as inflation
+	data, err = json.Marshal(&RewardsConfig{})
+	require.NoError(t, err)
+	require.NotContains(t, string(data), "rewardSource")
+	require.True(t, (&RewardsConfig{}).Equal(&RewardsConfig{RewardSource: RewardSourceInflation}))
+}
+
+func TestRewardsConfig_UnmarshalTokenAmount(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		walletAmount string
+		expected     *big.Int
+	}{
+		{`"0x3e8"`, big.NewInt(1000)},
+		{`"1000"`, big.NewInt(1000)},
+		{`"0.5 MIND"`, big.NewInt(500_000_000_000_000_000)},
+		{`"1e-15 MIND"`, big.NewInt(1000)},
+	}
+
+	for _, c := range cases {
+		config := &RewardsConfig{}
+		require.NoError(t, json.Unmarshal([]byte(`{"rewardWalletAmount": `+c.walletAmount+`}`), config))
+		require.Equal(t, 0, c.expected.Cmp(config.WalletAmount), c.walletAmount)
+	}
+
+	// token amounts without the symbol are ambiguous
+	for _, walletAmount := range []string{`"10.5"`, `"1e6"`, `"1.5 too many tokens"`} {
+		config := &RewardsConfig{}
+		require.Error(t, json.Unmarshal([]byte(`{"rewardWalletAmount": `+walletAmount+`}`), config), walletAmount)
+	}
+}
+
+func TestPolyBFTConfig_EpochRewardWei(t *testing.T) {
+	t.Parallel()
+
+	t.Run("legacy epoch reward", func(t *testing.T) {
+		t.Parallel()
+
+		var config PolyBFTConfig
+
+		require.NoError(t, json.Unmarshal([]byte(`{"epochReward": 5}`), &config))
+		require.Nil(t, config.EpochRewardWei)
+		require.Equal(t, big.NewInt(5), config.EpochRewardAmount(hclog.NewNullLogger()))
+	})
+
+	t.Run("epoch reward wei takes precedence", func(t *testing.T) {
+		t.Parallel()
+
+		var config PolyBFTConfig
+
+		require.NoError(t, json.Unmarshal([]byte(`{"epochReward": 5, "epochRewardWei": "0x3635c9adc5dea00000"}`), &config))
+		require.Equal(t, uint64(5), config.EpochReward)
+
+		expected, ok := new(big.Int).SetString("1000000000000000000000", 10)
+		require.True(t, ok)
+		require.Equal(t, expected, config.EpochRewardAmount(hclog.NewNullLogger()))
+	})
+
+	t.Run("round trip", func(t *testing.T) {
+		t.Parallel()
+
+		reward, ok := new(big.Int).SetString("1000000000000000000000", 10)
+		require.True(t, ok)
+
+		original := &PolyBFTConfig{EpochSize: 10, EpochReward: 1, EpochRewardWei: reward}
+
+		data, err := json.Marshal(original)
+		require.NoError(t, err)
+
+		var decoded PolyBFTConfig
+
+		require.NoError(t, json.Unmarshal(data, &decoded))
+		require.Equal(t, original.EpochSize, decoded.EpochSize)
+		require.Equal(t, original.EpochReward, decoded.EpochReward)
+		require.Equal(t, original.EpochRewardWei, decoded.EpochRewardWei)
+	})
+
+	t.Run("invalid epoch reward wei", func(t *testing.T) {
+		t.Parallel()
+
+		var config PolyBFTConfig
+
+		require.ErrorContains(t, json.Unmarshal([]byte(`{"epochRewardWei": "abc"}`), &config), "epochRewardWei")
+	})
+}
+
+func TestPolyBFTConfig_RewardPoolAddress(t *testing.T) {
+	t.Parallel()
+
+	// the address is pinned, since the reward pool system contract is deployed at it at genesis
+	require.Equal(t, types.StringToAddress("0x105"), (&PolyBFTConfig{}).RewardPoolAddress())
+	config := MinimalValidPolyBFTConfig()
+	require.Equal(t, contracts.RewardPoolContract, config.RewardPoolAddress())
+}
+
+func TestPolyBFTConfig_RewardsEnabled(t *testing.T) {
+	t.Parallel()
+
+	config := MinimalValidPolyBFTConfig()
+	config.EpochReward = 1
+
+	// nil reward config, but non-zero epoch reward
+	require.False(t, config.RewardsEnabled())
+	require.NoError(t, config.Validate())
+
+	// reward config set, but zero epoch reward
+	config.EpochReward = 0
+	config.RewardConfig = &RewardsConfig{WalletAmount: big.NewInt(0)}
+	require.False(t, config.RewardsEnabled())
+	require.NoError(t, config.Validate())
+
+	config.EpochRewardWei = big.NewInt(1)
+	require.True(t, config.RewardsEnabled())
+
+	err := config.Validate()
+	require.ErrorContains(t, err, "governance must not be zero address when rewards are enabled")
+	require.ErrorContains(t, err, "rewardWalletAddress must not be zero address when rewards are enabled")
+
+	// inflation rate enables the rewards regardless of the epoch reward
+	config.EpochRewardWei = nil
+	config.RewardConfig.InflationRate = big.NewInt(500)
+	require.True(t, config.RewardsEnabled())
+	require.ErrorContains(t, config.Validate(), "governance must not be zero address when rewards are enabled")
+
+	config.RewardConfig.InflationRate = big.NewInt(0)
+	require.False(t, config.RewardsEnabled())
+	require.NoError(t, config.Validate())
+}
+
+func TestPolyBFTConfig_EpochsFundedByRewardWallet(t *testing.T) {
+	t.Parallel()
+
+	config := MinimalValidPolyBFTConfig()
+	config.Governance = types.StringToAddress("2")
+	config.EpochRewardWei = big.NewInt(10)
+
+	_, err := config.EpochsFundedByRewardWallet()
+	require.ErrorContains(t, err, "rewards config is missing")
+
+	config.RewardConfig = &RewardsConfig{WalletAddress: types.StringToAddress("3"), WalletAmount: big.NewInt(105)}
+
+	epochs, err := config.EpochsFundedByRewardWallet()
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), epochs)
+	require.NoError(t, config.Validate())
+
+	// funded epochs below the threshold are reported as a warning
+	config.MinFundedEpochs = 11
+	warnings, err := SplitValidationWarnings(config.Validate())
+	require.NoError(t, err)
+	require.Len(t, warnings, 1)
+	require.Contains(t, warnings[0].Message, "reward wallet funds only 10 epochs, which is less than minFundedEpochs (11)")
+
+	// the wallet can't fund a single epoch
+	config.RewardConfig.WalletAmount = big.NewInt(9)
+	_, err = config.EpochsFundedByRewardWallet()
+	require.ErrorContains(t, err, "reward wallet can't fund a single epoch (rewardWalletAmount=9, epochReward=10)")
+	require.ErrorContains(t, config.Validate(), "reward wallet can't fund a single epoch")
+
+	// rewards paid from the fees don't drain the reward wallet
+	config.RewardConfig.RewardSource = RewardSourceFees
+	require.NoError(t, config.Validate())
+
+	config.EpochRewardWei = big.NewInt(0)
+	_, err = config.EpochsFundedByRewardWallet()
+	require.ErrorContains(t, err, "epoch reward must be greater than 0")
+}
+
+func TestPolyBFTConfig_ValidateRewardSource(t *testing.T) {
+	t.Parallel()
+
+	config := MinimalValidPolyBFTConfig()
+	config.EpochReward = 1
+	config.Governance = types.StringToAddress("2")
+	config.RewardConfig = &RewardsConfig{WalletAmount: big.NewInt(0), RewardSource: RewardSourceFees}
+
+	// fees don't need the reward wallet
+	require.False(t, config.RewardConfig.RequiresFundedWallet())
+	require.NoError(t, config.Validate())
+
+	for _, source := range []string{RewardSourceInflation, RewardSourceHybrid} {
+		config.RewardConfig.RewardSource = source
+		require.True(t, config.RewardConfig.RequiresFundedWallet())
+		require.ErrorContains(t, config.Validate(), "rewardWalletAddress must not be zero address", source)
+	}
+
+	config.RewardConfig.RewardSource = "tips"
+	require.ErrorContains(t, config.Validate(), "rewardSource must be one of inflation, fees or hybrid (rewardSource=tips)")
+
+	config.RewardConfig.RewardSource = RewardSourceFees
+	config.RewardConfig.RewardDistribution = "random"
+	require.ErrorContains(t, config.Validate(), "rewardDistribution must be one of equal, stake-weighted or "+
+		"uptime-weighted (rewardDistribution=random)")
+}
+
+func TestPolyBFTConfig_UnmarshalRewardAmountWithNativeTokenDecimals(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		token    string
+		amount   string
+		expected *big.Int
+	}{
+		{"native token decimals", `"nativeTokenConfig": {"name": "Mind", "symbol": "MIND", "decimals": 6},`,
+			`"2.5 MIND"`, big.NewInt(2_500_000)},
+		{"default decimals", "", `"2.5 MIND"`, big.NewInt(2_500_000_000_000_000_000)},
+		{"base units", `"nativeTokenConfig": {"name": "Mind", "symbol": "MIND", "decimals": 6},`,
+			`"1000"`, big.NewInt(1000)},
+	}
+
+	for _, c := range cases {
+		c := c
+
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			var config PolyBFTConfig
+
+			require.NoError(t, json.Unmarshal([]byte(`{`+c.token+`"rewardConfig": {"rewardWalletAmount": `+
+				c.amount+`}}`), &config))
+			require.Equal(t, 0, c.expected.Cmp(config.RewardConfig.WalletAmount))
+
+			// the amount is marshaled in base units, so it survives the round trip
+			data, err := json.Marshal(config)
+			require.NoError(t, err)
+
+			var decoded PolyBFTConfig
+
+			require.NoError(t, json.Unmarshal(data, &decoded))
+			require.Equal(t, 0, c.expected.Cmp(decoded.RewardConfig.WalletAmount))
+		})
+	}
+
+	var config PolyBFTConfig
+
+	err := json.Unmarshal([]byte(`{"nativeTokenConfig": {"name": "Mind", "symbol": "MIND", "decimals": 6}, `+
+		`"rewardConfig": {"rewardWalletAmount": "0.0000001 MIND"}}`), &config)
+	require.ErrorContains(t, err, "more fractional digits than token decimals (6)")
+}
+
+func TestPolyBFTConfig_ProjectedAnnualReward(t *testing.T) {
+	t.Parallel()
+
+	ether := new(big.Int).Exp(big.NewInt(10), big.NewInt(18), nil)
+	// 100 validators with 1M tokens staked each
+	validatorStake := new(big.Int).Mul(big.NewInt(1_000_000), ether)
+	totalStake := new(big.Int).Mul(big.NewInt(100), validatorStake)
+
+	newConfig := func() *PolyBFTConfig {
+		// 20s epochs, that is 1,576,800 epochs per year
+		return &PolyBFTConfig{
+			EpochSize:      10,
+			SprintSize:     5,
+			BlockTime:      common.Duration{Duration: 2 * time.Second},
+			EpochRewardWei: new(big.Int).Set(ether),
+		}
+	}
+
+	t.Run("fixed epoch reward", func(t *testing.T) {
+		t.Parallel()
+
+		reward, err := newConfig().ProjectedAnnualReward(validatorStake, totalStake)
+		require.NoError(t, err)
+
+		expected, _ := new(big.Int).SetString("15768000000000000000000", 10)
+		require.Equal(t, 0, expected.Cmp(reward), reward.String())
+	})
+
+	t.Run("inflation", func(t *testing.T) {
+		t.Parallel()
+
+		config := newConfig()
+		config.RewardConfig = &RewardsConfig{InflationRate: big.NewInt(500)}
+
+		reward, err := config.ProjectedAnnualReward(validatorStake, totalStake)
+		require.NoError(t, err)
+
+		// 5% of the validator stake, less the rounding of the per epoch reward
+		expected, _ := new(big.Int).SetString("49999999999999999993200", 10)
+		require.Equal(t, 0, expected.Cmp(reward), reward.String())
+	})
+
+	t.Run("invalid input", func(t *testing.T) {
+		t.Parallel()
+
+		config := newConfig()
+
+		_, err := config.ProjectedAnnualReward(validatorStake, big.NewInt(0))
+		require.ErrorContains(t, err, "total stake must be greater than 0")
+
+		_, err = config.ProjectedAnnualReward(validatorStake, nil)
+		require.ErrorContains(t, err, "total stake must be greater than 0")
+
+		_, err = config.ProjectedAnnualReward(totalStake, validatorStake)
+		require.ErrorContains(t, err, "validator stake must be between 0 and total stake")
+
+		config.SprintSize = 0
+		_, err = config.ProjectedAnnualReward(validatorStake, totalStake)
+		require.ErrorIs(t, err, ErrZeroSprintSize)
+
+		config.SprintSize = 5
+		config.BlockTime = common.Duration{}
+		_, err = config.ProjectedAnnualReward(validatorStake, totalStake)
+		require.ErrorContains(t, err, "epoch duration must be greater than 0")
+	})
+}
+
+func TestPolyBFTConfig_GenesisEpochReward(t *testing.T) {
+	t.Parallel()
+
+	logger := hclog.NewNullLogger()
+
+	config := MinimalValidPolyBFTConfig()
+	config.EpochRewardWei = big.NewInt(7)
+	require.Equal(t, big.NewInt(7), config.GenesisEpochReward(logger))
+
+	// inflation only, the reward is derived from the stake of the initial validators (315,360 epochs per year)
+	config.EpochRewardWei = nil
+	config.InitialValidatorSet[0].Stake = big.NewInt(315_360_000)
+	config.RewardConfig = &RewardsConfig{InflationRate: big.NewInt(maxBasisPoints)}
+	require.True(t, config.RewardsEnabled())
+	require.Equal(t, big.NewInt(1000), config.GenesisEpochReward(logger))
+
+	config.BlockTime = common.Duration{}
+	require.Zero(t, config.GenesisEpochReward(logger).Sign())
+}
+
+func TestPolyBFTConfig_MaxEpochReward(t *testing.T) {
+	t.Parallel()
+
+	newConfig := func() *PolyBFTConfig {
+		config := MinimalValidPolyBFTConfig()
+		config.EpochRewardWei = big.NewInt(1000)
+		config.MaxEpochReward = big.NewInt(1000)
+
+		return &config
+	}
+
+	t.Run("reward within the cap", func(t *testing.T) {
+		t.Parallel()
+
+		config := newConfig()
+		require.NoError(t, config.Validate())
+		require.Equal(t, big.NewInt(1000), config.EpochRewardAmount(hclog.NewNullLogger()))
+	})
+
+	t.Run("reward exceeds the cap", func(t *testing.T) {
+		t.Parallel()
+
+		config := newConfig()
+		config.EpochRewardWei = big.NewInt(10000)
+
+		require.ErrorContains(t, config.Validate(), "epoch reward must not exceed maxEpochReward")
+
+		var output strings.Builder
+
+		logger := hclog.New(&hclog.LoggerOptions{Output: &output})
+		require.Equal(t, big.NewInt(1000), config.EpochRewardAmount(logger))
+		require.Contains(t, output.String(), "epoch reward exceeds the maximum epoch reward")
+	})
+
+	t.Run("negative cap", func(t *testing.T) {
+		t.Parallel()
+
+		config := newConfig()
+		config.MaxEpochReward = big.NewInt(-1)
+
+		require.ErrorContains(t, config.Validate(), "maxEpochReward must not be negative")
+	})
+
+	t.Run("no cap", func(t *testing.T) {
+		t.Parallel()
+
+		config := newConfig()
+		config.MaxEpochReward = nil
+		config.EpochRewardWei = big.NewInt(10000)
+
+		require.Equal(t, big.NewInt(10000), config.EpochRewardAmount(hclog.NewNullLogger()))
+	})
+
+	t.Run("JSON encoding", func(t *testing.T) {
+		t.Parallel()
+
+		config := newConfig()
+
+		data, err := json.Marshal(config)
+		require.NoError(t, err)
+		require.Contains(t, string(data), `"maxEpochReward":"0x3e8"`)
+
+		var decoded PolyBFTConfig
+
+		require.NoError(t, json.Unmarshal(data, &decoded))
+		require.True(t, config.Equal(&decoded))
+	})
+}
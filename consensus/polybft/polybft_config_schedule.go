@@ -0,0 +1,209 @@
+package polybft
+
+import (
+	"math"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// MaxValidatorSetSizeAt returns the maximum size of validator set in the given epoch.
+// It is the size of the latest ValidatorSetSizeSchedule entry starting at or before the epoch,
+// or MaxValidatorSetSize if the schedule is empty or the epoch precedes its first entry.
+func (p *PolyBFTConfig) MaxValidatorSetSizeAt(epoch uint64) uint64 {
+	size := p.MaxValidatorSetSize
+
+	for _, change := range p.ValidatorSetSizeSchedule {
+		if change.FromEpoch > epoch {
+			break
+		}
+
+		size = change.Size
+	}
+
+	return size
+}
+
+// ParamAt returns the value of the given consensus parameter scheduled for the given epoch by ParamSchedule,
+// that is the value of the latest change of the field at or before the epoch. False is returned if
+// the parameter is not changed by the schedule until the epoch, in which case the config field applies.
+func (p *PolyBFTConfig) ParamAt(field string, epoch uint64) (string, bool) {
+	var (
+		value string
+		found bool
+	)
+
+	for _, change := range p.ParamSchedule {
+		if change.AtEpoch > epoch {
+			break
+		}
+
+		if change.Field == field {
+			value, found = change.Value, true
+		}
+	}
+
+	return value, found
+}
+
+// SizeChange sets the maximum size of validator set, starting from the given epoch
+type SizeChange struct {
+	FromEpoch uint64 `json:"fromEpoch"`
+	Size      uint64 `json:"size"`
+}
+
+// ScheduledChange sets the consensus parameter (given by its JSON field name) to the value, starting from the epoch
+type ScheduledChange struct {
+	AtEpoch uint64 `json:"atEpoch"`
+	Field   string `json:"field"`
+	Value   string `json:"value"`
+}
+
+// schedulableParams are the consensus parameters which can be changed by ParamSchedule,
+// along with the parsers validating their scheduled values
+var schedulableParams = map[string]func(string) error{
+	"epochReward":          validateUint64Param,
+	"epochRewardWei":       validateBigIntParam,
+	"maxEpochReward":       validateBigIntParam,
+	"blockGasLimit":        validateUint64Param,
+	"blockGasTarget":       validateUint64Param,
+	"withdrawalWaitPeriod": validateUint64Param,
+}
+
+// validateUint64Param checks that the scheduled value is a decimal uint64
+func validateUint64Param(value string) error {
+	_, err := strconv.ParseUint(value, 10, 64)
+
+	return err
+}
+
+// validateBigIntParam checks that the scheduled value is a decimal or hex encoded uint256
+func validateBigIntParam(value string) error {
+	_, err := types.ParseUint256orHex(&value)
+
+	return err
+}
+
+// schedulableParamNames returns the sorted names of the schedulableParams
+func schedulableParamNames() []string {
+	names := make([]string, 0, len(schedulableParams))
+	for name := range schedulableParams {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+// WithdrawalUnlockEpoch returns the epoch from which the funds unstaked in the given epoch can be withdrawn.
+// The result saturates at the maximum epoch number rather than overflowing.
+func (p *PolyBFTConfig) WithdrawalUnlockEpoch(requestEpoch uint64) uint64 {
+	if requestEpoch > math.MaxUint64-p.WithdrawalWaitPeriod {
+		return math.MaxUint64
+	}
+
+	return requestEpoch + p.WithdrawalWaitPeriod
+}
+
+// BlocksPerEpoch returns the number of blocks in a single epoch.
+// EpochSize is expressed in blocks, so this is the same as EpochSize.
+func (p *PolyBFTConfig) BlocksPerEpoch() uint64 {
+	return p.EpochSize
+}
+
+// TimeToFirstEpoch estimates the time it takes to finalize the first epoch (BlocksPerEpoch * BlockTime).
+// It returns 0 if the epoch size or the block time is not set and it saturates instead of overflowing.
+func (p *PolyBFTConfig) TimeToFirstEpoch() time.Duration {
+	return blocksDuration(p.BlocksPerEpoch(), p.BlockTime.Duration)
+}
+
+// TimeToFirstSprint estimates the time it takes to finalize the first sprint (SprintSize * BlockTime).
+// It returns 0 if the sprint size or the block time is not set and it saturates instead of overflowing.
+func (p *PolyBFTConfig) TimeToFirstSprint() time.Duration {
+	return blocksDuration(p.SprintSize, p.BlockTime.Duration)
+}
+
+// blocksDuration returns the time it takes to produce the given number of blocks, capped at the maximum duration
+func blocksDuration(blocks uint64, blockTime time.Duration) time.Duration {
+	if blocks == 0 || blockTime <= 0 {
+		return 0
+	}
+
+	if blocks > uint64(math.MaxInt64/blockTime) {
+		return math.MaxInt64
+	}
+
+	return time.Duration(blocks) * blockTime
+}
+
+// SprintsPerEpoch returns the number of whole sprints in a single epoch
+func (p *PolyBFTConfig) SprintsPerEpoch() (uint64, error) {
+	if p.SprintSize == 0 {
+		return 0, ErrZeroSprintSize
+	}
+
+	return p.EpochSize / p.SprintSize, nil
+}
+
+// IsEpochEndingBlock checks if the given block is the last block of an epoch of the configured size.
+// Genesis block (block 0) is not a part of any epoch, so the first epoch spans blocks [1, EpochSize].
+func (p *PolyBFTConfig) IsEpochEndingBlock(blockNumber uint64) bool {
+	if blockNumber == 0 || p.EpochSize == 0 {
+		return false
+	}
+
+	return blockNumber%p.EpochSize == 0
+}
+
+// IsSprintEndingBlock checks if the given block is the last block of a sprint of the configured size.
+// Since the epoch size is a multiple of the sprint size, every epoch ending block is a sprint ending block as well.
+func (p *PolyBFTConfig) IsSprintEndingBlock(blockNumber uint64) bool {
+	if blockNumber == 0 || p.SprintSize == 0 {
+		return false
+	}
+
+	return blockNumber%p.SprintSize == 0
+}
+
+// EpochNumberForBlock returns the number of the epoch the given block belongs to.
+// Epochs are numbered from 1, while the genesis block (and any block if EpochSize is not set) yields 0.
+func (p *PolyBFTConfig) EpochNumberForBlock(blockNumber uint64) uint64 {
+	if blockNumber == 0 || p.EpochSize == 0 {
+		return 0
+	}
+
+	return (blockNumber-1)/p.EpochSize + 1
+}
+
+// EpochBoundaries returns the epoch ending blocks within the inclusive range [fromBlock, toBlock], in ascending order.
+// The range doesn't need to be aligned to the epoch boundaries. Genesis block is never an epoch ending block.
+func (p *PolyBFTConfig) EpochBoundaries(fromBlock, toBlock uint64) []uint64 {
+	if p.EpochSize == 0 || fromBlock > toBlock || toBlock < p.EpochSize {
+		return nil
+	}
+
+	// the first epoch ending block at or after fromBlock
+	boundary := p.EpochNumberForBlock(fromBlock) * p.EpochSize
+	if boundary == 0 {
+		boundary = p.EpochSize
+	}
+
+	if boundary > toBlock {
+		return nil
+	}
+
+	boundaries := make([]uint64, 0, (toBlock-boundary)/p.EpochSize+1)
+
+	for ; boundary <= toBlock; boundary += p.EpochSize {
+		boundaries = append(boundaries, boundary)
+
+		if boundary > math.MaxUint64-p.EpochSize {
+			break
+		}
+	}
+
+	return boundaries
+}
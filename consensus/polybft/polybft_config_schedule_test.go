@@ -0,0 +1,264 @@
+package polybft
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/validator"
+	"github.com/0xPolygon/polygon-edge/helper/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPolyBFTConfig_MaxValidatorSetSizeAt(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Empty schedule falls back to MaxValidatorSetSize", func(t *testing.T) {
+		t.Parallel()
+
+		config := &PolyBFTConfig{MaxValidatorSetSize: 7}
+		require.Equal(t, uint64(7), config.MaxValidatorSetSizeAt(0))
+		require.Equal(t, uint64(7), config.MaxValidatorSetSizeAt(1000))
+	})
+
+	t.Run("Schedule", func(t *testing.T) {
+		t.Parallel()
+
+		config := DefaultPolyBFTConfig()
+		config.InitialValidatorSet = []*validator.GenesisValidator{{}, {}, {}, {}, {}, {}}
+		config.ValidatorSetSizeSchedule = []SizeChange{
+			{FromEpoch: 1, Size: 5},
+			{FromEpoch: 20, Size: 50},
+			{FromEpoch: 50, Size: 100},
+		}
+
+		cases := map[uint64]uint64{0: 100, 1: 5, 19: 5, 20: 50, 49: 50, 50: 100, 1000: 100}
+		for epoch, expectedSize := range cases {
+			require.Equal(t, expectedSize, config.MaxValidatorSetSizeAt(epoch), "epoch %d", epoch)
+		}
+
+		// the initial validator set is capped by the size of the first epoch
+		require.Equal(t, uint64(5), config.ActiveValidatorCount())
+
+		warnings, err := SplitValidationWarnings(config.Validate())
+		require.NoError(t, err)
+		require.Len(t, warnings, 1)
+		require.Contains(t, warnings[0].Message, "1 validator(s) will be dropped from the active set")
+	})
+
+	t.Run("Invalid schedule", func(t *testing.T) {
+		t.Parallel()
+
+		config := DefaultPolyBFTConfig()
+		config.InitialValidatorSet = []*validator.GenesisValidator{{}}
+		config.ValidatorSetSizeSchedule = []SizeChange{
+			{FromEpoch: 10, Size: 5},
+			{FromEpoch: 10, Size: 10},
+			{FromEpoch: 5, Size: 0},
+		}
+
+		err := config.Validate()
+		require.ErrorContains(t, err, "validatorSetSizeSchedule[2] size must be greater than 0")
+		require.ErrorContains(t, err, "validatorSetSizeSchedule[0].fromEpoch=10, validatorSetSizeSchedule[1].fromEpoch=10")
+		require.ErrorContains(t, err, "validatorSetSizeSchedule[1].fromEpoch=10, validatorSetSizeSchedule[2].fromEpoch=5")
+	})
+
+	t.Run("JSON round trip", func(t *testing.T) {
+		t.Parallel()
+
+		config := &PolyBFTConfig{
+			MaxValidatorSetSize:      100,
+			ValidatorSetSizeSchedule: []SizeChange{{FromEpoch: 1, Size: 5}, {FromEpoch: 50, Size: 100}},
+		}
+
+		data, err := json.Marshal(config)
+		require.NoError(t, err)
+		require.Contains(t, string(data), `"validatorSetSizeSchedule":[{"fromEpoch":1,"size":5},{"fromEpoch":50,"size":100}]`)
+
+		var decoded PolyBFTConfig
+		require.NoError(t, json.Unmarshal(data, &decoded))
+		require.Equal(t, config.ValidatorSetSizeSchedule, decoded.ValidatorSetSizeSchedule)
+		require.True(t, config.Equal(&decoded))
+	})
+}
+
+func TestPolyBFTConfig_ParamSchedule(t *testing.T) {
+	t.Parallel()
+
+	config := MinimalValidPolyBFTConfig()
+
+	_, ok := config.ParamAt("epochReward", 100)
+	require.False(t, ok)
+
+	config.ParamSchedule = []ScheduledChange{
+		{AtEpoch: 10, Field: "epochReward", Value: "5"},
+		{AtEpoch: 10, Field: "blockGasLimit", Value: "30000000"},
+		{AtEpoch: 20, Field: "epochReward", Value: "3"},
+		{AtEpoch: 30, Field: "maxEpochReward", Value: "0x3e8"},
+	}
+	require.NoError(t, config.Validate())
+
+	cases := []struct {
+		field    string
+		epoch    uint64
+		expected string
+		found    bool
+	}{
+		{"epochReward", 9, "", false},
+		{"epochReward", 10, "5", true},
+		{"epochReward", 19, "5", true},
+		{"epochReward", 20, "3", true},
+		{"epochReward", 1000, "3", true},
+		{"blockGasLimit", 10, "30000000", true},
+		{"maxEpochReward", 29, "", false},
+		{"maxEpochReward", 30, "0x3e8", true},
+		{"withdrawalWaitPeriod", 1000, "", false},
+	}
+
+	for _, c := range cases {
+		value, found := config.ParamAt(c.field, c.epoch)
+		require.Equal(t, c.expected, value, "%s at %d", c.field, c.epoch)
+		require.Equal(t, c.found, found, "%s at %d", c.field, c.epoch)
+	}
+
+	data, err := json.Marshal(config)
+	require.NoError(t, err)
+	require.Contains(t, string(data), `"paramSchedule":[{"atEpoch":10,"field":"epochReward","value":"5"},`)
+
+	var decoded PolyBFTConfig
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	require.True(t, config.Equal(&decoded))
+
+	config.ParamSchedule = []ScheduledChange{
+		{AtEpoch: 20, Field: "epochReward", Value: "-1"},
+		{AtEpoch: 10, Field: "sprintSize", Value: "5"},
+		{AtEpoch: 10, Field: "blockGasLimit", Value: "1"},
+		{AtEpoch: 10, Field: "blockGasLimit", Value: "2"},
+	}
+
+	err = config.Validate()
+	require.ErrorContains(t, err, "paramSchedule[0] value of epochReward is invalid (value=-1)")
+	require.ErrorContains(t, err, "paramSchedule[1] field must be one of blockGasLimit, blockGasTarget, "+
+		"epochReward, epochRewardWei, maxEpochReward, withdrawalWaitPeriod (field=sprintSize)")
+	require.ErrorContains(t, err, "paramSchedule must be sorted by atEpoch "+
+		"(paramSchedule[0].atEpoch=20, paramSchedule[1].atEpoch=10)")
+	require.ErrorContains(t, err, "paramSchedule changes blockGasLimit more than once at epoch 10")
+}
+
+func TestPolyBFTConfig_TimeToFirstEpoch(t *testing.T) {
+	t.Parallel()
+
+	config := DefaultPolyBFTConfig()
+	require.Equal(t, 100*time.Second, config.TimeToFirstEpoch())
+	require.Equal(t, 10*time.Second, config.TimeToFirstSprint())
+
+	require.Zero(t, (&PolyBFTConfig{}).TimeToFirstEpoch())
+	require.Zero(t, (&PolyBFTConfig{}).TimeToFirstSprint())
+	require.Zero(t, (&PolyBFTConfig{EpochSize: 10, SprintSize: 5}).TimeToFirstEpoch())
+	require.Zero(t, (&PolyBFTConfig{BlockTime: common.Duration{Duration: time.Second}}).TimeToFirstSprint())
+
+	config.EpochSize = math.MaxUint64
+	require.Equal(t, time.Duration(math.MaxInt64), config.TimeToFirstEpoch())
+}
+
+func TestPolyBFTConfig_EpochHelpers(t *testing.T) {
+	t.Parallel()
+
+	config := &PolyBFTConfig{EpochSize: 10, SprintSize: 5}
+	require.Equal(t, uint64(10), config.BlocksPerEpoch())
+
+	sprintsPerEpoch, err := config.SprintsPerEpoch()
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), sprintsPerEpoch)
+
+	require.False(t, config.IsEpochEndingBlock(0))
+	// first epoch
+	require.False(t, config.IsEpochEndingBlock(1))
+	require.True(t, config.IsEpochEndingBlock(10))
+	// second epoch
+	require.False(t, config.IsEpochEndingBlock(11))
+	require.True(t, config.IsEpochEndingBlock(20))
+
+	require.False(t, config.IsSprintEndingBlock(0))
+	// first sprint
+	require.False(t, config.IsSprintEndingBlock(4))
+	require.True(t, config.IsSprintEndingBlock(5))
+	require.False(t, config.IsEpochEndingBlock(5))
+	// second sprint, ending the first epoch as well
+	require.False(t, config.IsSprintEndingBlock(6))
+	require.True(t, config.IsSprintEndingBlock(10))
+
+	for block := uint64(0); block <= 100; block++ {
+		if config.IsEpochEndingBlock(block) {
+			require.True(t, config.IsSprintEndingBlock(block), "block %d", block)
+		}
+	}
+
+	require.False(t, (&PolyBFTConfig{}).IsSprintEndingBlock(5))
+}
+
+func TestPolyBFTConfig_ZeroSprintSize(t *testing.T) {
+	t.Parallel()
+
+	config := &PolyBFTConfig{EpochSize: 10}
+
+	_, err := config.SprintsPerEpoch()
+	require.ErrorIs(t, err, ErrZeroSprintSize)
+	require.ErrorContains(t, config.Validate(), "sprintSize must be greater than 0")
+}
+
+func TestPolyBFTConfig_WithdrawalUnlockEpoch(t *testing.T) {
+	t.Parallel()
+
+	config := MinimalValidPolyBFTConfig()
+	require.Equal(t, uint64(5), config.WithdrawalUnlockEpoch(5))
+
+	config.WithdrawalWaitPeriod = 3
+	require.Equal(t, uint64(8), config.WithdrawalUnlockEpoch(5))
+	require.Equal(t, uint64(math.MaxUint64), config.WithdrawalUnlockEpoch(math.MaxUint64-1))
+	require.NoError(t, config.Validate())
+
+	var decoded PolyBFTConfig
+	require.NoError(t, json.Unmarshal([]byte(`{"withdrawalWaitPeriod": 3}`), &decoded))
+	require.Equal(t, uint64(3), decoded.WithdrawalWaitPeriod)
+}
+
+func TestPolyBFTConfig_EpochBoundaries(t *testing.T) {
+	t.Parallel()
+
+	config := &PolyBFTConfig{EpochSize: 10}
+
+	epochs := map[uint64]uint64{0: 0, 1: 1, 9: 1, 10: 1, 11: 2, 20: 2, 21: 3}
+	for block, expectedEpoch := range epochs {
+		require.Equal(t, expectedEpoch, config.EpochNumberForBlock(block), "block %d", block)
+	}
+
+	cases := []struct {
+		fromBlock, toBlock uint64
+		expected           []uint64
+	}{
+		{0, 0, nil},
+		{0, 9, nil},
+		{0, 10, []uint64{10}},
+		{0, 35, []uint64{10, 20, 30}},
+		{10, 30, []uint64{10, 20, 30}},
+		{11, 29, []uint64{20}},
+		{11, 19, nil},
+		{25, 15, nil},
+		{math.MaxUint64 - 25, math.MaxUint64, []uint64{math.MaxUint64 - 25, math.MaxUint64 - 15, math.MaxUint64 - 5}},
+	}
+
+	for _, c := range cases {
+		require.Equal(t, c.expected, config.EpochBoundaries(c.fromBlock, c.toBlock),
+			"range [%d, %d]", c.fromBlock, c.toBlock)
+	}
+
+	for _, boundary := range config.EpochBoundaries(1, 100) {
+		require.True(t, config.IsEpochEndingBlock(boundary))
+	}
+
+	// epoch size not set
+	require.Nil(t, (&PolyBFTConfig{}).EpochBoundaries(0, 100))
+	require.Equal(t, uint64(0), (&PolyBFTConfig{}).EpochNumberForBlock(100))
+}
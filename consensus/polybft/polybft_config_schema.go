@@ -0,0 +1,284 @@
+package polybft
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+const (
+	jsonSchemaDraft07 = "http://json-schema.org/draft-07/schema#"
+
+	addressPattern     = "^0x[0-9a-fA-F]{40}$"
+	hashPattern        = "^0x[0-9a-fA-F]{64}$"
+	bigIntPattern      = "^(0x[0-9a-fA-F]+|[0-9]+)$"
+	tokenAmountPattern = "^\\s*[0-9]*\\.?[0-9]*([eE][-+]?[0-9]+)?(\\s+\\S+)?\\s*$"
+	durationPattern    = "^([0-9]+(\\.[0-9]+)?(ns|us|µs|ms|s|m|h))+$"
+)
+
+// PolyBFTConfigJSONSchema returns JSON schema (draft-07) describing the JSON encoding of the PolyBFTConfig,
+// which can be used to validate genesis files by the tools which are not written in Go
+func PolyBFTConfigJSONSchema() ([]byte, error) {
+	ref := func(definition string) map[string]interface{} {
+		return map[string]interface{}{"$ref": "#/definitions/" + definition}
+	}
+
+	nullable := func(schema map[string]interface{}) map[string]interface{} {
+		return map[string]interface{}{"oneOf": []interface{}{schema, map[string]interface{}{"type": "null"}}}
+	}
+
+	uint64Schema := map[string]interface{}{"type": "integer", "minimum": 0}
+
+	bridgeProperties := map[string]interface{}{
+		"jsonRPCEndpoint": map[string]interface{}{"type": "string"},
+		"jsonRPCEndpoints": map[string]interface{}{
+			"type":  "array",
+			"items": map[string]interface{}{"type": "string", "format": "uri"},
+		},
+		"eventTrackerStartBlocks": nullable(map[string]interface{}{
+			"type":                 "object",
+			"propertyNames":        map[string]interface{}{"pattern": addressPattern},
+			"additionalProperties": uint64Schema,
+		}),
+		"checkpointInterval": map[string]interface{}{"type": "integer", "minimum": 1},
+		"maxBridgeBatchSize": map[string]interface{}{"type": "integer", "minimum": 1},
+		"rootchainKind": map[string]interface{}{
+			"type": "string",
+			"enum": []string{RootchainKindEVM, RootchainKindCustom},
+		},
+		"extra": map[string]interface{}{
+			"type":                 "object",
+			"additionalProperties": map[string]interface{}{"type": "string"},
+		},
+		"explorerURL": map[string]interface{}{"type": "string", "pattern": "^https://"},
+		"pausedPredicates": map[string]interface{}{
+			"type":  "array",
+			"items": map[string]interface{}{"type": "string", "pattern": "^[eE][rR][cC](20|721|1155)$"},
+		},
+		"bridgeActivationBlock": uint64Schema,
+	}
+
+	for _, field := range []string{
+		"stateSenderAddress", "checkpointManagerAddress", "exitHelperAddress",
+		"erc20PredicateAddress", "nativeERC20Address", "erc721Address", "erc721PredicateAddress",
+		"erc1155Address", "erc1155PredicateAddress", "customSupernetManagerAddr", "stakeManagerAddr",
+		"proxyAdminAddress",
+	} {
+		bridgeProperties[field] = ref("address")
+	}
+
+	definitions := map[string]interface{}{
+		"address":  map[string]interface{}{"type": "string", "pattern": addressPattern},
+		"hash":     map[string]interface{}{"type": "string", "pattern": hashPattern},
+		"bigInt":   map[string]interface{}{"type": "string", "pattern": bigIntPattern},
+		"duration": map[string]interface{}{"type": []string{"string", "integer"}, "pattern": durationPattern},
+		"genesisValidator": map[string]interface{}{
+			"type":     "object",
+			"required": []string{"address", "blsKey"},
+			"properties": map[string]interface{}{
+				"address":   ref("address"),
+				"blsKey":    map[string]interface{}{"type": "string", "pattern": "^[0-9a-fA-F]*$"},
+				"balance":   nullable(ref("bigInt")),
+				"stake":     nullable(ref("bigInt")),
+				"multiAddr": map[string]interface{}{"type": "string"},
+			},
+		},
+		"bridgeConfig": map[string]interface{}{
+			"type":       "object",
+			"properties": bridgeProperties,
+			// the custom rootchain requires the adapter settings instead of the rootchain contracts
+			"if": map[string]interface{}{
+				"required":   []string{"rootchainKind"},
+				"properties": map[string]interface{}{"rootchainKind": map[string]interface{}{"const": RootchainKindCustom}},
+			},
+			"then": map[string]interface{}{
+				"required":   []string{"extra"},
+				"properties": map[string]interface{}{"extra": map[string]interface{}{"minProperties": 1}},
+			},
+			"else": map[string]interface{}{
+				"required": []string{"stateSenderAddress", "checkpointManagerAddress", "exitHelperAddress"},
+			},
+		},
+		"tokenConfig": map[string]interface{}{
+			"type":     "object",
+			"required": []string{"name", "symbol", "decimals"},
+			"properties": map[string]interface{}{
+				"name":        map[string]interface{}{"type": "string", "minLength": 1},
+				"symbol":      map[string]interface{}{"type": "string", "minLength": 1, "maxLength": maxTokenSymbolLength},
+				"decimals":    map[string]interface{}{"type": "integer", "minimum": 0, "maximum": maxTokenDecimals},
+				"isMintable":  map[string]interface{}{"type": "boolean"},
+				"totalSupply": ref("bigInt"),
+				"logoURI":     map[string]interface{}{"type": "string", "pattern": "^(https|ipfs)://"},
+				"description": map[string]interface{}{"type": "string"},
+			},
+		},
+		"rewardsConfig": map[string]interface{}{
+			"type":     "object",
+			"required": []string{"rewardTokenAddress", "rewardWalletAddress"},
+			"properties": map[string]interface{}{
+				"rewardTokenAddress":  ref("address"),
+				"rewardWalletAddress": ref("address"),
+				"rewardWalletAmount": nullable(map[string]interface{}{
+					"anyOf": []interface{}{
+						ref("bigInt"),
+						map[string]interface{}{"type": "string", "pattern": tokenAmountPattern},
+						map[string]interface{}{"const": RewardWalletAmountUnlimited},
+					},
+				}),
+				"rewardInflationRate": ref("bigInt"),
+				"rewardSource": map[string]interface{}{
+					"type": "string",
+					"enum": []string{RewardSourceInflation, RewardSourceFees, RewardSourceHybrid},
+				},
+				"rewardDistribution": map[string]interface{}{
+					"type": "string",
+					"enum": []string{
+						RewardDistributionEqual, RewardDistributionStakeWeighted, RewardDistributionUptimeWeighted,
+					},
+				},
+			},
+		},
+		"slashingConfig": map[string]interface{}{
+			"type": "object",
+			"properties": map[string]interface{}{
+				"doubleSignPenalty":      ref("bigInt"),
+				"downtimePenalty":        ref("bigInt"),
+				"downtimeEpochThreshold": uint64Schema,
+			},
+		},
+		"baseFeeConfig": map[string]interface{}{
+			"type":     "object",
+			"required": []string{"baseFeeChangeDenom", "elasticityMultiplier"},
+			"properties": map[string]interface{}{
+				"baseFeeChangeDenom":   map[string]interface{}{"type": "integer", "minimum": 1},
+				"elasticityMultiplier": map[string]interface{}{"type": "integer", "minimum": 1},
+				"initialBaseFee":       ref("bigInt"),
+			},
+		},
+		"tokenMint": map[string]interface{}{
+			"type":     "object",
+			"required": []string{"address", "amount"},
+			"properties": map[string]interface{}{
+				"address": ref("address"),
+				"amount":  ref("bigInt"),
+			},
+		},
+		"faucetConfig": map[string]interface{}{
+			"type":     "object",
+			"required": []string{"address", "amount"},
+			"properties": map[string]interface{}{
+				"address": ref("address"),
+				"amount":  ref("bigInt"),
+			},
+		},
+		"scheduledChange": map[string]interface{}{
+			"type":     "object",
+			"required": []string{"atEpoch", "field", "value"},
+			"properties": map[string]interface{}{
+				"atEpoch": uint64Schema,
+				"field":   map[string]interface{}{"type": "string", "enum": schedulableParamNames()},
+				"value":   map[string]interface{}{"type": "string"},
+			},
+		},
+		"genesisContract": map[string]interface{}{
+			"type":     "object",
+			"required": []string{"address", "code"},
+			"properties": map[string]interface{}{
+				"address": ref("address"),
+				"code":    map[string]interface{}{"type": "string", "pattern": "^(0x)?([0-9a-fA-F]{2})*$"},
+				"balance": ref("bigInt"),
+				"storage": map[string]interface{}{
+					"type":                 "object",
+					"propertyNames":        map[string]interface{}{"pattern": hashPattern},
+					"additionalProperties": ref("hash"),
+				},
+			},
+		},
+		"sizeChange": map[string]interface{}{
+			"type":     "object",
+			"required": []string{"fromEpoch", "size"},
+			"properties": map[string]interface{}{
+				"fromEpoch": map[string]interface{}{"type": "integer", "minimum": 0},
+				"size":      map[string]interface{}{"type": "integer", "minimum": 1},
+			},
+		},
+	}
+
+	schema := map[string]interface{}{
+		"$schema":     jsonSchemaDraft07,
+		"title":       "PolyBFTConfig",
+		"description": fmt.Sprintf("Configuration of the %s consensus protocol", ConsensusName),
+		"type":        "object",
+		"required":    []string{"initialValidatorSet", "epochSize", "sprintSize", "blockTime", "maxValidatorSetSize"},
+		"properties": map[string]interface{}{
+			"configVersion": map[string]interface{}{
+				"type":    "integer",
+				"minimum": 0,
+				"maximum": CurrentPolyBFTConfigVersion,
+			},
+			"minNodeVersion": map[string]interface{}{"type": "string"},
+			"initialValidatorSet": map[string]interface{}{
+				"type":     "array",
+				"minItems": 1,
+				"items":    ref("genesisValidator"),
+			},
+			"bridge": nullable(ref("bridgeConfig")),
+			"bridges": map[string]interface{}{
+				"type":                 "object",
+				"propertyNames":        map[string]interface{}{"pattern": "^[0-9]+$"},
+				"additionalProperties": ref("bridgeConfig"),
+			},
+			"epochSize":           map[string]interface{}{"type": "integer", "minimum": 1},
+			"epochReward":         uint64Schema,
+			"epochRewardWei":      ref("bigInt"),
+			"maxEpochReward":      ref("bigInt"),
+			"minFundedEpochs":     uint64Schema,
+			"sprintSize":          map[string]interface{}{"type": "integer", "minimum": 1},
+			"blockTime":           ref("duration"),
+			"blockTimeDrift":      ref("duration"),
+			"blockTimeMax":        ref("duration"),
+			"governance":          ref("address"),
+			"nativeTokenConfig":   nullable(ref("tokenConfig")),
+			"initialTrieRoot":     ref("hash"),
+			"maxValidatorSetSize": map[string]interface{}{"type": "integer", "minimum": 1},
+			"validatorSetSizeSchedule": map[string]interface{}{
+				"type":  "array",
+				"items": ref("sizeChange"),
+			},
+			"excludedValidators": map[string]interface{}{
+				"type":  "array",
+				"items": ref("address"),
+			},
+			"rewardConfig": nullable(ref("rewardsConfig")),
+			"premineMints": map[string]interface{}{
+				"type":  "array",
+				"items": ref("tokenMint"),
+			},
+			"slashingConfig":       nullable(ref("slashingConfig")),
+			"baseFeeConfig":        nullable(ref("baseFeeConfig")),
+			"withdrawalWaitPeriod": uint64Schema,
+			"faucetConfig":         nullable(ref("faucetConfig")),
+			"allowList": map[string]interface{}{
+				"type":  "array",
+				"items": ref("address"),
+			},
+			"blockList": map[string]interface{}{
+				"type":  "array",
+				"items": ref("address"),
+			},
+			"blockGasLimit":  uint64Schema,
+			"blockGasTarget": uint64Schema,
+			"genesisContracts": map[string]interface{}{
+				"type":  "array",
+				"items": ref("genesisContract"),
+			},
+			"paramSchedule": map[string]interface{}{
+				"type":  "array",
+				"items": ref("scheduledChange"),
+			},
+			"minValidatorStake": ref("bigInt"),
+		},
+		"definitions": definitions,
+	}
+
+	return json.MarshalIndent(schema, "", "  ")
+}
@@ -0,0 +1,51 @@
+package polybft
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/validator"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPolyBFTConfigJSONSchema(t *testing.T) {
+	t.Parallel()
+
+	data, err := PolyBFTConfigJSONSchema()
+	require.NoError(t, err)
+
+	var schema struct {
+		Schema      string                            `json:"$schema"`
+		Required    []string                          `json:"required"`
+		Properties  map[string]json.RawMessage        `json:"properties"`
+		Definitions map[string]map[string]interface{} `json:"definitions"`
+	}
+
+	require.NoError(t, json.Unmarshal(data, &schema))
+	require.Equal(t, "http://json-schema.org/draft-07/schema#", schema.Schema)
+	require.ElementsMatch(t,
+		[]string{"initialValidatorSet", "epochSize", "sprintSize", "blockTime", "maxValidatorSetSize"}, schema.Required)
+
+	// every field of the encoded config must be described by the schema
+	config := DefaultPolyBFTConfig()
+	config.InitialValidatorSet = []*validator.GenesisValidator{{Balance: big.NewInt(1), Stake: big.NewInt(1)}}
+	config.EpochRewardWei = big.NewInt(1)
+	config.Bridges = map[uint64]*BridgeConfig{1: {}}
+	config.PremineMints = []*TokenMint{{Address: types.StringToAddress("1"), Amount: big.NewInt(1)}}
+
+	encoded, err := json.Marshal(config)
+	require.NoError(t, err)
+
+	var fields map[string]json.RawMessage
+
+	require.NoError(t, json.Unmarshal(encoded, &fields))
+
+	for field := range fields {
+		require.Contains(t, schema.Properties, field)
+	}
+
+	tokenConfig := schema.Definitions["tokenConfig"]["properties"].(map[string]interface{}) //nolint:forcetypeassert
+	require.Equal(t, map[string]interface{}{"type": "integer", "minimum": 0.0, "maximum": 18.0}, tokenConfig["decimals"])
+}
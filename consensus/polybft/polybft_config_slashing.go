@@ -0,0 +1,113 @@
+package polybft
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/go-multierror"
+)
+
+// SlashingEnabled indicates whether misbehaving validators are penalized,
+// that is if SlashingConfig is set and at least one of its penalties is greater than zero
+func (p *PolyBFTConfig) SlashingEnabled() bool {
+	if p.SlashingConfig == nil {
+		return false
+	}
+
+	return (p.SlashingConfig.DoubleSignPenalty != nil && p.SlashingConfig.DoubleSignPenalty.Sign() > 0) ||
+		(p.SlashingConfig.DowntimePenalty != nil && p.SlashingConfig.DowntimePenalty.Sign() > 0)
+}
+
+// SlashingConfig defines the penalties (in the native token base units) of the misbehaving validators
+type SlashingConfig struct {
+	// DoubleSignPenalty is the amount slashed from the stake of the validator which signed conflicting blocks
+	DoubleSignPenalty *big.Int
+
+	// DowntimePenalty is the amount slashed from the stake of the validator which was offline
+	// for DowntimeEpochThreshold consecutive epochs
+	DowntimePenalty *big.Int
+
+	// DowntimeEpochThreshold is the number of consecutive epochs the validator has to be offline to be slashed
+	DowntimeEpochThreshold uint64
+}
+
+type slashingConfigRaw struct {
+	DoubleSignPenalty      *string `json:"doubleSignPenalty,omitempty"`
+	DowntimePenalty        *string `json:"downtimePenalty,omitempty"`
+	DowntimeEpochThreshold uint64  `json:"downtimeEpochThreshold"`
+}
+
+func (s *SlashingConfig) MarshalJSON() ([]byte, error) {
+	raw := &slashingConfigRaw{DowntimeEpochThreshold: s.DowntimeEpochThreshold}
+
+	if s.DoubleSignPenalty != nil {
+		raw.DoubleSignPenalty = types.EncodeBigInt(s.DoubleSignPenalty)
+	}
+
+	if s.DowntimePenalty != nil {
+		raw.DowntimePenalty = types.EncodeBigInt(s.DowntimePenalty)
+	}
+
+	return json.Marshal(raw)
+}
+
+func (s *SlashingConfig) UnmarshalJSON(data []byte) error {
+	var (
+		raw slashingConfigRaw
+		err error
+	)
+
+	if err = json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	s.DowntimeEpochThreshold = raw.DowntimeEpochThreshold
+
+	s.DoubleSignPenalty, err = types.ParseUint256orHex(raw.DoubleSignPenalty)
+	if err != nil {
+		return fmt.Errorf("doubleSignPenalty: %w", err)
+	}
+
+	s.DowntimePenalty, err = types.ParseUint256orHex(raw.DowntimePenalty)
+	if err != nil {
+		return fmt.Errorf("downtimePenalty: %w", err)
+	}
+
+	return nil
+}
+
+// Copy returns a deep copy of the SlashingConfig
+func (s *SlashingConfig) Copy() *SlashingConfig {
+	cp := *s
+	cp.DoubleSignPenalty = copyBigInt(s.DoubleSignPenalty)
+	cp.DowntimePenalty = copyBigInt(s.DowntimePenalty)
+
+	return &cp
+}
+
+// Validate checks that the penalties are not negative and that the downtime penalty has the threshold to apply at
+func (s *SlashingConfig) Validate() error {
+	var err error
+
+	if s.DoubleSignPenalty != nil && s.DoubleSignPenalty.Sign() < 0 {
+		err = multierror.Append(err, fieldErrorf("doubleSignPenalty",
+			"doubleSignPenalty must not be negative (doubleSignPenalty=%s)",
+			s.DoubleSignPenalty))
+	}
+
+	if s.DowntimePenalty != nil && s.DowntimePenalty.Sign() < 0 {
+		err = multierror.Append(err, fieldErrorf("downtimePenalty",
+			"downtimePenalty must not be negative (downtimePenalty=%s)",
+			s.DowntimePenalty))
+	}
+
+	if s.DowntimePenalty != nil && s.DowntimePenalty.Sign() > 0 && s.DowntimeEpochThreshold == 0 {
+		err = multierror.Append(err, fieldErrorf("downtimeEpochThreshold",
+			"downtimeEpochThreshold must be greater than 0 when downtime "+
+				"penalty is set (downtimeEpochThreshold=%d)", s.DowntimeEpochThreshold))
+	}
+
+	return err
+}
@@ -0,0 +1,49 @@
+package polybft
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPolyBFTConfig_Slashing(t *testing.T) {
+	t.Parallel()
+
+	config := MinimalValidPolyBFTConfig()
+	require.False(t, config.SlashingEnabled())
+
+	config.SlashingConfig = &SlashingConfig{}
+	require.False(t, config.SlashingEnabled())
+	require.NoError(t, config.Validate())
+
+	config.SlashingConfig.DoubleSignPenalty = big.NewInt(-1)
+	config.SlashingConfig.DowntimePenalty = big.NewInt(-2)
+	err := config.Validate()
+	require.ErrorContains(t, err, "doubleSignPenalty must not be negative (doubleSignPenalty=-1)")
+	require.ErrorContains(t, err, "downtimePenalty must not be negative (downtimePenalty=-2)")
+
+	config.SlashingConfig.DoubleSignPenalty = big.NewInt(1000)
+	config.SlashingConfig.DowntimePenalty = big.NewInt(10)
+	require.ErrorContains(t, config.Validate(), "downtimeEpochThreshold must be greater than 0")
+
+	config.SlashingConfig.DowntimeEpochThreshold = 3
+	require.NoError(t, config.Validate())
+	require.True(t, config.SlashingEnabled())
+
+	// big integers survive the JSON round trip
+	data, err := json.Marshal(config)
+	require.NoError(t, err)
+	require.Contains(t, string(data), `"slashingConfig":{"doubleSignPenalty":"0x3e8","downtimePenalty":"0xa",`+
+		`"downtimeEpochThreshold":3}`)
+
+	var decoded PolyBFTConfig
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	require.True(t, config.Equal(&decoded))
+
+	// the config without slashing encodes the same as before
+	data, err = json.Marshal(MinimalValidPolyBFTConfig())
+	require.NoError(t, err)
+	require.NotContains(t, string(data), "slashingConfig")
+}
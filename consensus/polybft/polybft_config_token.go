@@ -0,0 +1,249 @@
+package polybft
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/url"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/go-multierror"
+)
+
+// NativeTokenMode returns the way the native token supply is managed:
+// NativeTokenModeMintable if the native token is mintable, NativeTokenModeBridged if it is not mintable
+// and the (legacy) bridge is configured, or NativeTokenModeNonBridgedFixed otherwise
+func (p *PolyBFTConfig) NativeTokenMode() string {
+	switch {
+	case p.NativeTokenConfig != nil && p.NativeTokenConfig.IsMintable:
+		return NativeTokenModeMintable
+	case p.Bridge != nil:
+		return NativeTokenModeBridged
+	default:
+		return NativeTokenModeNonBridgedFixed
+	}
+}
+
+// rootNativeERC20Addr returns the address of the rootchain native token, or zero address if bridge is not configured
+func (p *PolyBFTConfig) rootNativeERC20Addr() types.Address {
+	if p.Bridge == nil {
+		return types.ZeroAddress
+	}
+
+	return p.Bridge.RootNativeERC20Addr
+}
+
+// TokenConfig is the configuration of native token used by edge network
+type TokenConfig struct {
+	Name       string `json:"name"`
+	Symbol     string `json:"symbol"`
+	Decimals   uint8  `json:"decimals"`
+	IsMintable bool   `json:"isMintable"`
+
+	// TotalSupply is the optional intended total supply of the fixed supply token, see VerifySupplyInvariant
+	TotalSupply *big.Int `json:"-"`
+
+	// LogoURI is the optional https or ipfs URI of the token logo, meant for the explorers
+	LogoURI string `json:"logoURI,omitempty"`
+	// Description is the optional human readable description of the token, meant for the explorers
+	Description string `json:"description,omitempty"`
+}
+
+// String implements fmt.Stringer interface
+func (t *TokenConfig) String() string {
+	return fmt.Sprintf("Name=%s; Symbol=%s; Decimals=%d; Mintable=%t; Logo URI=%s; Description=%s;",
+		t.Name, t.Symbol, t.Decimals, t.IsMintable, t.LogoURI, t.Description)
+}
+
+// tokenConfigAlias is used to (un)marshal TokenConfig without recursing into its own (un)marshaling methods
+type tokenConfigAlias TokenConfig
+
+type tokenConfigRaw struct {
+	*tokenConfigAlias
+	TotalSupply *string `json:"totalSupply,omitempty"`
+}
+
+func (t TokenConfig) MarshalJSON() ([]byte, error) {
+	alias := tokenConfigAlias(t)
+	raw := &tokenConfigRaw{tokenConfigAlias: &alias}
+
+	if t.TotalSupply != nil {
+		raw.TotalSupply = types.EncodeBigInt(t.TotalSupply)
+	}
+
+	return json.Marshal(raw)
+}
+
+func (t *TokenConfig) UnmarshalJSON(data []byte) error {
+	var (
+		raw = &tokenConfigRaw{tokenConfigAlias: (*tokenConfigAlias)(t)}
+		err error
+	)
+
+	if err = json.Unmarshal(data, raw); err != nil {
+		return err
+	}
+
+	t.TotalSupply, err = types.ParseUint256orHex(raw.TotalSupply)
+	if err != nil {
+		return fmt.Errorf("totalSupply: %w", err)
+	}
+
+	return nil
+}
+
+// Validate checks that the token name, symbol and decimals (and the logo URI, if set) are well-formed
+func (t *TokenConfig) Validate() error {
+	var err error
+
+	if t.Decimals > maxTokenDecimals {
+		err = multierror.Append(err, fieldErrorf("decimals", "decimals must not be greater than %d (decimals=%d)",
+			maxTokenDecimals, t.Decimals))
+	}
+
+	if strings.TrimSpace(t.Name) == "" {
+		err = multierror.Append(err, fieldErrorf("name", "name must not be empty (name=%q)", t.Name))
+	} else if containsControlChars(t.Name) {
+		err = multierror.Append(err, fieldErrorf("name", "name must not contain control characters (name=%q)", t.Name))
+	}
+
+	if symbolLen := utf8.RuneCountInString(t.Symbol); symbolLen < 1 || symbolLen > maxTokenSymbolLength {
+		err = multierror.Append(err, fieldErrorf("symbol", "symbol must be between 1 and %d characters long (symbol=%q)",
+			maxTokenSymbolLength, t.Symbol))
+	} else if containsControlChars(t.Symbol) {
+		err = multierror.Append(err, fieldErrorf("symbol",
+			"symbol must not contain control characters (symbol=%q)", t.Symbol))
+	}
+
+	if t.TotalSupply != nil && t.TotalSupply.Sign() < 0 {
+		err = multierror.Append(err, fieldErrorf("totalSupply",
+			"totalSupply must not be negative (totalSupply=%s)", t.TotalSupply))
+	}
+
+	if t.LogoURI != "" {
+		if logoURI, uriErr := url.Parse(t.LogoURI); uriErr != nil ||
+			(logoURI.Scheme != "https" && logoURI.Scheme != "ipfs") || logoURI.Host == "" {
+			err = multierror.Append(err, fieldErrorf("logoURI", "logoURI must be an https or ipfs URI (logoURI=%q)", t.LogoURI))
+		}
+	}
+
+	return err
+}
+
+// IsFixedSupply indicates whether the token supply is fixed (i.e. token is not mintable)
+func (t *TokenConfig) IsFixedSupply() bool {
+	return !t.IsMintable
+}
+
+// containsControlChars checks whether provided string contains any control character
+func containsControlChars(s string) bool {
+	return strings.IndexFunc(s, unicode.IsControl) != -1
+}
+
+// TokenMint is the initial mint of the native token to the given address
+type TokenMint struct {
+	Address types.Address
+	Amount  *big.Int
+}
+
+// String implements fmt.Stringer interface
+func (t *TokenMint) String() string {
+	return fmt.Sprintf("Address=%s; Amount=%v;", t.Address, t.Amount)
+}
+
+func (t *TokenMint) MarshalJSON() ([]byte, error) {
+	raw := &tokenMintRaw{Address: t.Address}
+	if t.Amount != nil {
+		raw.Amount = types.EncodeBigInt(t.Amount)
+	}
+
+	return json.Marshal(raw)
+}
+
+func (t *TokenMint) UnmarshalJSON(data []byte) error {
+	var (
+		raw tokenMintRaw
+		err error
+	)
+
+	if err = json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	t.Address = raw.Address
+
+	t.Amount, err = types.ParseUint256orHex(raw.Amount)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+type tokenMintRaw struct {
+	Address types.Address `json:"address"`
+	Amount  *string       `json:"amount"`
+}
+
+// weiToTokens converts the provided amount in wei to the decimal token amount approximation
+func weiToTokens(amount *big.Int, decimals uint8) string {
+	denominator := new(big.Float).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(decimals)), nil))
+
+	return new(big.Float).Quo(new(big.Float).SetInt(amount), denominator).Text('f', 4)
+}
+
+// ParseTokenAmount parses human readable token amount (e.g. "10", "10.5", "1e6" or "100 MIND")
+// and scales it to the base units of the token with the given number of decimals.
+// The optional suffix is the token symbol and it is not checked against the actual token.
+// Amounts which can not be expressed in the base units (too many fractional digits) are rejected.
+func ParseTokenAmount(s string, decimals uint8) (*big.Int, error) {
+	fields := strings.Fields(s)
+	if len(fields) == 0 || len(fields) > 2 {
+		return nil, fmt.Errorf("invalid token amount %q", s)
+	}
+
+	if len(fields) == 2 && (utf8.RuneCountInString(fields[1]) > maxTokenSymbolLength || containsControlChars(fields[1])) {
+		return nil, fmt.Errorf("invalid token symbol in amount %q", s)
+	}
+
+	mantissa, exponent := fields[0], 0
+
+	if i := strings.IndexAny(mantissa, "eE"); i != -1 {
+		exp, err := strconv.Atoi(mantissa[i+1:])
+		if err != nil || exp > maxTokenAmountExponent || exp < -maxTokenAmountExponent {
+			return nil, fmt.Errorf("invalid exponent in token amount %q", s)
+		}
+
+		mantissa, exponent = mantissa[:i], exp
+	}
+
+	intPart, fracPart, _ := strings.Cut(mantissa, ".")
+	digits := intPart + fracPart
+
+	if digits == "" || strings.IndexFunc(digits, func(r rune) bool { return r < '0' || r > '9' }) != -1 {
+		return nil, fmt.Errorf("invalid token amount %q", s)
+	}
+
+	amount, _ := new(big.Int).SetString(digits, 10)
+
+	if scale := int(decimals) + exponent - len(fracPart); scale >= 0 {
+		amount.Mul(amount, new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(scale)), nil))
+	} else {
+		remainder := new(big.Int)
+		amount.QuoRem(amount, new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(-scale)), nil), remainder)
+
+		if remainder.Sign() != 0 {
+			return nil, fmt.Errorf("token amount %q has more fractional digits than token decimals (%d)", s, decimals)
+		}
+	}
+
+	if amount.BitLen() > 256 {
+		return nil, fmt.Errorf("token amount %q exceeds uint256", s)
+	}
+
+	return amount, nil
+}
@@ -0,0 +1,224 @@
+package polybft
+
+import (
+	"encoding/json"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTokenConfig_Validate(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name        string
+		config      *TokenConfig
+		expectedErr string
+	}{
+		{"zero decimals", &TokenConfig{Name: "Polygon", Symbol: "MATIC", Decimals: 0}, ""},
+		{"max decimals", &TokenConfig{Name: "Polygon", Symbol: "MATIC", Decimals: 18}, ""},
+		{"too many decimals", &TokenConfig{Name: "Polygon", Symbol: "MATIC", Decimals: 19},
+			"decimals must not be greater than 18 (decimals=19)"},
+		{"blank name", &TokenConfig{Name: "  ", Symbol: "MATIC", Decimals: 18}, "name must not be empty"},
+		{"control characters in name", &TokenConfig{Name: "Poly\ngon", Symbol: "MATIC", Decimals: 18},
+			"name must not contain control characters"},
+		{"empty symbol", &TokenConfig{Name: "Polygon", Decimals: 18}, "symbol must be between 1 and 11 characters long"},
+		{"too long symbol", &TokenConfig{Name: "Polygon", Symbol: "ABCDEFGHIJKL", Decimals: 18},
+			"symbol must be between 1 and 11 characters long"},
+		{"control characters in symbol", &TokenConfig{Name: "Polygon", Symbol: "MA\tIC", Decimals: 18},
+			"symbol must not contain control characters"},
+		{"https logo", &TokenConfig{Name: "Mind", Symbol: "MIND", LogoURI: "https://example.com/mind.png"}, ""},
+		{"ipfs logo", &TokenConfig{Name: "Mind", Symbol: "MIND", LogoURI: "ipfs://bafybeigdyrzt5sfp7udm7hu76uh7y26"}, ""},
+		{"http logo", &TokenConfig{Name: "Mind", Symbol: "MIND", LogoURI: "http://example.com/mind.png"},
+			`logoURI must be an https or ipfs URI (logoURI="http://example.com/mind.png")`},
+		{"relative logo", &TokenConfig{Name: "Mind", Symbol: "MIND", LogoURI: "mind.png"},
+			"logoURI must be an https or ipfs URI"},
+	}
+
+	for _, c := range cases {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := c.config.Validate()
+			if c.expectedErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, c.expectedErr)
+			}
+		})
+	}
+}
+
+func TestTokenConfig_IsFixedSupply(t *testing.T) {
+	t.Parallel()
+
+	require.True(t, (&TokenConfig{IsMintable: false}).IsFixedSupply())
+	require.False(t, (&TokenConfig{IsMintable: true}).IsFixedSupply())
+}
+
+func TestTokenConfig_Metadata(t *testing.T) {
+	t.Parallel()
+
+	token := &TokenConfig{
+		Name:        "Mind",
+		Symbol:      "MIND",
+		Decimals:    18,
+		LogoURI:     "https://example.com/mind.png",
+		Description: "Native token of the Mind chain",
+	}
+
+	data, err := json.Marshal(token)
+	require.NoError(t, err)
+	require.Contains(t, string(data),
+		`"logoURI":"https://example.com/mind.png","description":"Native token of the Mind chain"`)
+
+	decoded := &TokenConfig{}
+	require.NoError(t, json.Unmarshal(data, decoded))
+	require.Equal(t, token, decoded)
+
+	// metadata is optional and it is omitted from the encoding when unset
+	data, err = json.Marshal(&TokenConfig{Name: "Mind", Symbol: "MIND"})
+	require.NoError(t, err)
+	require.NotContains(t, string(data), "logoURI")
+	require.NotContains(t, string(data), "description")
+
+	require.Equal(t, "Name=Mind; Symbol=MIND; Decimals=18; Mintable=false; "+
+		"Logo URI=https://example.com/mind.png; Description=Native token of the Mind chain;", token.String())
+
+	config := MinimalValidPolyBFTConfig()
+	config.NativeTokenConfig = token
+	require.NoError(t, config.Validate())
+	require.True(t, strings.HasSuffix(config.String(), "Native token=MIND\n"+
+		"Native token logo URI=https://example.com/mind.png\nNative token description=Native token of the Mind chain"))
+}
+
+func TestParseTokenAmount(t *testing.T) {
+	t.Parallel()
+
+	ether := new(big.Int).Exp(big.NewInt(10), big.NewInt(18), nil)
+
+	cases := []struct {
+		input       string
+		decimals    uint8
+		expected    *big.Int
+		expectedErr string
+	}{
+		{"10", 18, new(big.Int).Mul(big.NewInt(10), ether), ""},
+		{"10.5", 18, new(big.Int).Mul(big.NewInt(105), new(big.Int).Div(ether, big.NewInt(10))), ""},
+		{"1e6", 18, new(big.Int).Mul(big.NewInt(1_000_000), ether), ""},
+		{"100 MIND", 18, new(big.Int).Mul(big.NewInt(100), ether), ""},
+		{" 2.5E2 MIND ", 2, big.NewInt(25000), ""},
+		{"0.000001", 6, big.NewInt(1), ""},
+		{"1.50", 1, big.NewInt(15), ""},
+		{".5", 1, big.NewInt(5), ""},
+		{"0.0000001", 6, nil, "more fractional digits than token decimals (6)"},
+		{"1e-7", 6, nil, "more fractional digits than token decimals (6)"},
+		{"-1", 18, nil, "invalid token amount"},
+		{"abc", 18, nil, "invalid token amount"},
+		{"", 18, nil, "invalid token amount"},
+		{"1 2 3", 18, nil, "invalid token amount"},
+		{"1eX", 18, nil, "invalid exponent"},
+		{"1 VERYLONGSYMBOL", 18, nil, "invalid token symbol"},
+		{"1e78", 18, nil, "exceeds uint256"},
+	}
+
+	for _, c := range cases {
+		c := c
+		t.Run(c.input, func(t *testing.T) {
+			t.Parallel()
+
+			amount, err := ParseTokenAmount(c.input, c.decimals)
+			if c.expectedErr != "" {
+				require.ErrorContains(t, err, c.expectedErr)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, 0, c.expected.Cmp(amount), "expected %s, got %s", c.expected, amount)
+		})
+	}
+}
+
+func TestPolyBFTConfig_NativeTokenMode(t *testing.T) {
+	t.Parallel()
+
+	newConfig := func(isMintable bool, bridge *BridgeConfig) *PolyBFTConfig {
+		config := MinimalValidPolyBFTConfig()
+		config.Bridge = bridge
+		config.NativeTokenConfig.IsMintable = isMintable
+
+		return &config
+	}
+
+	newBridge := func(rootNativeERC20Addr types.Address) *BridgeConfig {
+		bridge := newTestBridgeConfig()
+		bridge.RootNativeERC20Addr = rootNativeERC20Addr
+
+		return bridge
+	}
+
+	cases := []struct {
+		name         string
+		config       *PolyBFTConfig
+		expectedMode string
+		expectedErr  string
+	}{
+		{"mintable", newConfig(true, nil), NativeTokenModeMintable, ""},
+		{"bridged", newConfig(false, newBridge(types.StringToAddress("5"))), NativeTokenModeBridged, ""},
+		{"non-bridged fixed", newConfig(false, nil), NativeTokenModeNonBridgedFixed, ""},
+		{
+			"mintable with rootchain native token",
+			newConfig(true, newBridge(types.StringToAddress("5"))),
+			NativeTokenModeMintable,
+			"",
+		},
+		{
+			"bridged without rootchain native token",
+			newConfig(false, newBridge(types.ZeroAddress)),
+			NativeTokenModeBridged,
+			"bridged native token requires rootchain native token",
+		},
+	}
+
+	for _, c := range cases {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, c.expectedMode, c.config.NativeTokenMode())
+
+			if c.expectedErr == "" {
+				require.NoError(t, c.config.Validate())
+			} else {
+				require.ErrorContains(t, c.config.Validate(), c.expectedErr)
+			}
+		})
+	}
+
+	require.Equal(t, NativeTokenModeNonBridgedFixed, (&PolyBFTConfig{}).NativeTokenMode())
+}
+
+func TestTokenConfig_TotalSupplyJSON(t *testing.T) {
+	t.Parallel()
+
+	token := &TokenConfig{Name: "Mind", Symbol: "MIND", Decimals: 18, TotalSupply: big.NewInt(1_000_000)}
+
+	data, err := json.Marshal(token)
+	require.NoError(t, err)
+	require.Contains(t, string(data), `"totalSupply":"0xf4240"`)
+
+	var decoded TokenConfig
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	require.True(t, tokenConfigEqual(token, &decoded))
+
+	// total supply is optional
+	require.NoError(t, json.Unmarshal([]byte(`{"name":"Mind","symbol":"MIND","decimals":18}`), &decoded))
+	require.Nil(t, decoded.TotalSupply)
+
+	require.ErrorContains(t, json.Unmarshal([]byte(`{"totalSupply":"abc"}`), &decoded), "totalSupply")
+}
@@ -0,0 +1,122 @@
+package polybft
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+)
+
+// LoadPolyBFTConfigFromTOML loads TOML encoded chain config from provided path and unmarshals PolyBFTConfig
+func LoadPolyBFTConfigFromTOML(chainConfigFile string) (PolyBFTConfig, int64, error) {
+	data, err := readConfigFile(chainConfigFile)
+	if err != nil {
+		return PolyBFTConfig{}, 0, err
+	}
+
+	var raw map[string]interface{}
+	if err := toml.Unmarshal(data, &raw); err != nil {
+		return PolyBFTConfig{}, 0, newConfigLoadError(ErrConfigMalformed, err)
+	}
+
+	jsonData, err := json.Marshal(raw)
+	if err != nil {
+		return PolyBFTConfig{}, 0, newConfigLoadError(ErrConfigMalformed, err)
+	}
+
+	return LoadPolyBFTConfigFromReader(bytes.NewReader(jsonData))
+}
+
+// MarshalTOML encodes PolyBFTConfig to a TOML document, using the same representation of values as JSON encoding.
+// TOML has no inline multi-line tables, so the config can only be encoded as a whole document.
+func (p PolyBFTConfig) MarshalTOML() ([]byte, error) {
+	data, err := json.Marshal(p)
+	if err != nil {
+		return nil, err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var raw map[string]interface{}
+	if err := decoder.Decode(&raw); err != nil {
+		return nil, err
+	}
+
+	value, err := normalizeTOMLValue(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	if err := toml.NewEncoder(&buf).Encode(value); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// UnmarshalTOML decodes TOML encoded PolyBFTConfig, accepting the same representation of values as JSON decoding
+func (p *PolyBFTConfig) UnmarshalTOML(value interface{}) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(data, p)
+}
+
+// normalizeTOMLValue converts the decoded JSON value to the value which can be encoded to TOML:
+// nulls are dropped (TOML has no null) and JSON numbers are converted to TOML integers or floats
+func normalizeTOMLValue(value interface{}) (interface{}, error) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		result := make(map[string]interface{}, len(v))
+
+		for key, item := range v {
+			if item == nil {
+				continue
+			}
+
+			normalized, err := normalizeTOMLValue(item)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", key, err)
+			}
+
+			result[key] = normalized
+		}
+
+		return result, nil
+	case []interface{}:
+		result := make([]interface{}, 0, len(v))
+
+		for i, item := range v {
+			if item == nil {
+				return nil, fmt.Errorf("item %d: null values are not supported by TOML", i)
+			}
+
+			normalized, err := normalizeTOMLValue(item)
+			if err != nil {
+				return nil, fmt.Errorf("item %d: %w", i, err)
+			}
+
+			result = append(result, normalized)
+		}
+
+		return result, nil
+	case json.Number:
+		if n, err := v.Int64(); err == nil {
+			return n, nil
+		}
+
+		if !strings.ContainsAny(v.String(), ".eE") {
+			return nil, fmt.Errorf("number %s is out of TOML integer range", v)
+		}
+
+		return v.Float64()
+	default:
+		return v, nil
+	}
+}
@@ -0,0 +1,84 @@
+package polybft
+
+import (
+	"bytes"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/validator"
+	"github.com/0xPolygon/polygon-edge/helper/common"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/BurntSushi/toml"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPolyBFTConfig_TOMLRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	stateSenderAddr := types.StringToAddress("10")
+	original := &PolyBFTConfig{
+		InitialValidatorSet: []*validator.GenesisValidator{
+			{Address: types.StringToAddress("1"), Balance: big.NewInt(100), Stake: big.NewInt(10), BlsKey: "bls"},
+		},
+		Bridge: &BridgeConfig{
+			StateSenderAddr:         stateSenderAddr,
+			JSONRPCEndpoint:         "http://127.0.0.1:8545",
+			EventTrackerStartBlocks: map[types.Address]uint64{stateSenderAddr: 5},
+		},
+		Bridges:    map[uint64]*BridgeConfig{5: {JSONRPCEndpoint: "http://127.0.0.1:9545"}},
+		EpochSize:  10,
+		SprintSize: 5,
+		BlockTime:  common.Duration{Duration: 2 * time.Second},
+		RewardConfig: &RewardsConfig{
+			TokenAddress:  types.StringToAddress("2"),
+			WalletAddress: types.StringToAddress("3"),
+			WalletAmount:  big.NewInt(1000),
+		},
+	}
+
+	var buf bytes.Buffer
+
+	require.NoError(t, toml.NewEncoder(&buf).Encode(original))
+
+	data := buf.Bytes()
+	require.Contains(t, string(data), "rewardWalletAmount = \"0x3e8\"")
+	require.Contains(t, string(data), "address = \""+types.StringToAddress("1").String()+"\"")
+
+	var decoded PolyBFTConfig
+
+	require.NoError(t, toml.Unmarshal(data, &decoded))
+	require.Equal(t, original, &decoded)
+}
+
+func TestPolyBFTConfig_LoadPolyBFTConfigFromTOML(t *testing.T) {
+	t.Parallel()
+
+	chainTOML := `
+[params]
+chainID = 100
+
+[params.engine.polybft]
+epochSize = 10
+sprintSize = 5
+blockTime = "2s"
+
+[params.engine.polybft.bridges.5]
+jsonRPCEndpoint = "http://127.0.0.1:9545"
+`
+
+	path := filepath.Join(t.TempDir(), "genesis.toml")
+	require.NoError(t, os.WriteFile(path, []byte(chainTOML), 0600))
+
+	config, chainID, err := LoadPolyBFTConfigFromTOML(path)
+	require.NoError(t, err)
+	require.Equal(t, int64(100), chainID)
+	require.Equal(t, uint64(10), config.EpochSize)
+	require.Equal(t, 2*time.Second, config.BlockTime.Duration)
+	require.Equal(t, "http://127.0.0.1:9545", config.BridgeForChain(5).JSONRPCEndpoint)
+
+	_, _, err = LoadPolyBFTConfigFromTOML(filepath.Join(t.TempDir(), "missing.toml"))
+	require.ErrorIs(t, err, ErrConfigNotFound)
+}
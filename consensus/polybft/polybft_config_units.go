@@ -0,0 +1,102 @@
+package polybft
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// ConfigUnits are the human readable token amounts (see ParseTokenAmount), which override
+// the corresponding base unit amounts of the PolyBFTConfig
+type ConfigUnits struct {
+	// RewardWalletAmount overrides RewardConfig.WalletAmount
+	RewardWalletAmount *string `json:"rewardWalletAmount,omitempty"`
+
+	// ValidatorStakes override the stakes of the initial validators, keyed by the validator address
+	ValidatorStakes map[types.Address]string `json:"validatorStakes,omitempty"`
+}
+
+// LoadPolyBFTConfigWithUnits loads the polybft config from the chain config at configPath and overrides
+// its token amounts with the ones from the units file at unitsPath. Amounts in the units file are expressed
+// in the native token units (e.g. "1500.5" or "1500.5 MIND") and they are scaled to the base units using
+// the native token decimals. The resulting config is validated.
+func LoadPolyBFTConfigWithUnits(configPath, unitsPath string) (PolyBFTConfig, int64, error) {
+	config, chainID, err := LoadPolyBFTConfig(configPath)
+	if err != nil {
+		return PolyBFTConfig{}, 0, err
+	}
+
+	data, err := readConfigFile(unitsPath)
+	if err != nil {
+		return PolyBFTConfig{}, 0, fmt.Errorf("failed to read units file %s: %w", unitsPath, err)
+	}
+
+	var units ConfigUnits
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+
+	if err := decoder.Decode(&units); err != nil {
+		return PolyBFTConfig{}, 0, fmt.Errorf("failed to decode units file %s: %w", unitsPath,
+			newConfigLoadError(ErrConfigMalformed, err))
+	}
+
+	if err := units.Apply(&config); err != nil {
+		return PolyBFTConfig{}, 0, fmt.Errorf("failed to apply units file %s: %w", unitsPath, err)
+	}
+
+	if _, err := SplitValidationWarnings(config.Validate()); err != nil {
+		return PolyBFTConfig{}, 0, err
+	}
+
+	return config, chainID, nil
+}
+
+// Apply parses the amounts into the base units of the native token and sets them to the config.
+// Validator stakes are applied in the order of the validator addresses.
+func (u *ConfigUnits) Apply(config *PolyBFTConfig) error {
+	if config.NativeTokenConfig == nil {
+		return errors.New("native token config is required to convert the token units")
+	}
+
+	decimals := config.NativeTokenConfig.Decimals
+
+	if u.RewardWalletAmount != nil {
+		if config.RewardConfig == nil {
+			return errors.New("rewardWalletAmount is set, but the rewards config is missing")
+		}
+
+		amount, err := ParseTokenAmount(*u.RewardWalletAmount, decimals)
+		if err != nil {
+			return fmt.Errorf("invalid rewardWalletAmount %q: %w", *u.RewardWalletAmount, err)
+		}
+
+		config.RewardConfig.WalletAmount = amount
+	}
+
+	addrs := make([]types.Address, 0, len(u.ValidatorStakes))
+	for addr := range u.ValidatorStakes {
+		addrs = append(addrs, addr)
+	}
+
+	sort.Slice(addrs, func(i, j int) bool {
+		return bytes.Compare(addrs[i][:], addrs[j][:]) < 0
+	})
+
+	for _, addr := range addrs {
+		stake, err := ParseTokenAmount(u.ValidatorStakes[addr], decimals)
+		if err != nil {
+			return fmt.Errorf("invalid stake %q of validator %s: %w", u.ValidatorStakes[addr], addr, err)
+		}
+
+		if err := config.SetValidatorStake(addr, stake); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
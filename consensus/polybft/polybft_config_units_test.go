@@ -0,0 +1,115 @@
+package polybft
+
+import (
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPolyBFTConfig_LoadPolyBFTConfigWithUnits(t *testing.T) {
+	t.Parallel()
+
+	const chainJSON = `{
+		"params": {
+			"chainID": 100,
+			"engine": {
+				"polybft": {
+					"initialValidatorSet": [
+						{"address": "0x0000000000000000000000000000000000000001", "balance": "0x1", "stake": "0x1"},
+						{"address": "0x0000000000000000000000000000000000000002", "balance": "0x1", "stake": "0x1"}
+					],
+					"epochSize": 10,
+					"sprintSize": 5,
+					"blockTime": "2s",
+					"maxValidatorSetSize": 100,
+					"governance": "0x0000000000000000000000000000000000000003",
+					"nativeTokenConfig": {"name": "Mind", "symbol": "MIND", "decimals": 6, "isMintable": false},
+					"rewardConfig": {
+						"rewardTokenAddress": "0x0000000000000000000000000000000000000004",
+						"rewardWalletAddress": "0x0000000000000000000000000000000000000005",
+						"rewardWalletAmount": "0x0"
+					}
+				}
+			}
+		}
+	}`
+
+	writeFiles := func(t *testing.T, unitsJSON string) (string, string) {
+		t.Helper()
+
+		dir := t.TempDir()
+		configPath, unitsPath := filepath.Join(dir, "genesis.json"), filepath.Join(dir, "units.json")
+
+		require.NoError(t, os.WriteFile(configPath, []byte(chainJSON), 0600))
+		require.NoError(t, os.WriteFile(unitsPath, []byte(unitsJSON), 0600))
+
+		return configPath, unitsPath
+	}
+
+	t.Run("units applied", func(t *testing.T) {
+		t.Parallel()
+
+		configPath, unitsPath := writeFiles(t, `{
+			"rewardWalletAmount": "1000000.5 MIND",
+			"validatorStakes": {
+				"0x0000000000000000000000000000000000000002": "1500.25"
+			}
+		}`)
+
+		config, chainID, err := LoadPolyBFTConfigWithUnits(configPath, unitsPath)
+		require.NoError(t, err)
+		require.Equal(t, int64(100), chainID)
+		require.Equal(t, big.NewInt(1_000_000_500_000), config.RewardConfig.WalletAmount)
+		require.Equal(t, big.NewInt(1), config.InitialValidatorSet[0].Stake)
+		require.Equal(t, big.NewInt(1_500_250_000), config.InitialValidatorSet[1].Stake)
+	})
+
+	t.Run("unknown validator", func(t *testing.T) {
+		t.Parallel()
+
+		configPath, unitsPath := writeFiles(t, `{
+			"validatorStakes": {"0x0000000000000000000000000000000000000009": "1"}
+		}`)
+
+		_, _, err := LoadPolyBFTConfigWithUnits(configPath, unitsPath)
+		require.ErrorContains(t, err, "is not part of the initial validator set")
+	})
+
+	t.Run("too many fractional digits", func(t *testing.T) {
+		t.Parallel()
+
+		configPath, unitsPath := writeFiles(t, `{"rewardWalletAmount": "0.0000001"}`)
+
+		_, _, err := LoadPolyBFTConfigWithUnits(configPath, unitsPath)
+		require.ErrorContains(t, err, `invalid rewardWalletAmount "0.0000001"`)
+	})
+
+	t.Run("unknown field", func(t *testing.T) {
+		t.Parallel()
+
+		configPath, unitsPath := writeFiles(t, `{"rewardWalletAmont": "1"}`)
+
+		_, _, err := LoadPolyBFTConfigWithUnits(configPath, unitsPath)
+		require.ErrorContains(t, err, "failed to decode units file")
+	})
+}
+
+func TestConfigUnits_Apply(t *testing.T) {
+	t.Parallel()
+
+	amount := "1"
+
+	require.ErrorContains(t, (&ConfigUnits{}).Apply(&PolyBFTConfig{}), "native token config is required")
+
+	config := &PolyBFTConfig{NativeTokenConfig: &TokenConfig{Decimals: 18}}
+	require.ErrorContains(t, (&ConfigUnits{RewardWalletAmount: &amount}).Apply(config),
+		"rewards config is missing")
+
+	config.RewardConfig = &RewardsConfig{WalletAddress: types.StringToAddress("1")}
+	require.NoError(t, (&ConfigUnits{RewardWalletAmount: &amount}).Apply(config))
+	require.Equal(t, new(big.Int).Exp(big.NewInt(10), big.NewInt(18), nil), config.RewardConfig.WalletAmount)
+}
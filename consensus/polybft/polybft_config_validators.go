@@ -0,0 +1,251 @@
+package polybft
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/validator"
+	"github.com/0xPolygon/polygon-edge/helper/common"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/go-multierror"
+)
+
+// ActiveValidatorCount returns the number of genesis validators which make it to the active validator set,
+// that is the number of initial validators which are not excluded, capped by the maximum validator set size
+// of the first epoch. Excluded validators do not count against the maximum validator set size.
+func (p *PolyBFTConfig) ActiveValidatorCount() uint64 {
+	return common.Min(uint64(len(p.ActiveValidators())), p.MaxValidatorSetSizeAt(firstEpoch))
+}
+
+// VotingPower returns the normalized voting power of the validators of the first epoch, keyed by their addresses.
+// The power is the validator stake relative to the total stake of the set, scaled to 1e18 (rounded down).
+// The set consists of the active validators (see ActiveValidators), truncated to the maximum validator set size
+// by keeping the validators with the highest stake. Ties are broken by the lower address.
+func (p *PolyBFTConfig) VotingPower() map[types.Address]*big.Int {
+	stakeOf := func(v *validator.GenesisValidator) *big.Int {
+		if v.Stake == nil {
+			return big.NewInt(0)
+		}
+
+		return v.Stake
+	}
+
+	validators := make([]*validator.GenesisValidator, 0, len(p.InitialValidatorSet))
+
+	for _, v := range p.ActiveValidators() {
+		if v != nil {
+			validators = append(validators, v)
+		}
+	}
+
+	sort.Slice(validators, func(i, j int) bool {
+		if c := stakeOf(validators[i]).Cmp(stakeOf(validators[j])); c != 0 {
+			return c > 0
+		}
+
+		return bytes.Compare(validators[i].Address[:], validators[j].Address[:]) < 0
+	})
+
+	if size := p.MaxValidatorSetSizeAt(firstEpoch); uint64(len(validators)) > size {
+		validators = validators[:size]
+	}
+
+	totalStake := big.NewInt(0)
+	for _, v := range validators {
+		totalStake.Add(totalStake, stakeOf(v))
+	}
+
+	scale := new(big.Int).Exp(big.NewInt(10), big.NewInt(votingPowerDecimals), nil)
+	powers := make(map[types.Address]*big.Int, len(validators))
+
+	for _, v := range validators {
+		power := big.NewInt(0)
+		if totalStake.Sign() > 0 {
+			power.Mul(stakeOf(v), scale).Div(power, totalStake)
+		}
+
+		powers[v.Address] = power
+	}
+
+	return powers
+}
+
+// MeetsMinStake checks whether the given stake is at least MinValidatorStake. Nil stake is treated as zero
+// and any stake meets the minimum when MinValidatorStake is nil.
+func (p *PolyBFTConfig) MeetsMinStake(stake *big.Int) bool {
+	if p.MinValidatorStake == nil {
+		return true
+	}
+
+	if stake == nil {
+		return p.MinValidatorStake.Sign() <= 0
+	}
+
+	return stake.Cmp(p.MinValidatorStake) >= 0
+}
+
+// ActiveValidators returns the initial validators which are not excluded, preserving their order.
+// The result is not capped by MaxValidatorSetSize.
+func (p *PolyBFTConfig) ActiveValidators() []*validator.GenesisValidator {
+	if len(p.ExcludedValidators) == 0 {
+		return p.InitialValidatorSet
+	}
+
+	validators := make([]*validator.GenesisValidator, 0, len(p.InitialValidatorSet))
+
+	for _, v := range p.InitialValidatorSet {
+		if v != nil && !p.IsValidatorExcluded(v.Address) {
+			validators = append(validators, v)
+		}
+	}
+
+	return validators
+}
+
+// ExcludeValidator marks the genesis validator with the given address as excluded from the active validator set
+// (e.g. because it is an archive node), while keeping it in the InitialValidatorSet
+func (p *PolyBFTConfig) ExcludeValidator(addr types.Address) {
+	if !p.IsValidatorExcluded(addr) {
+		p.ExcludedValidators = append(p.ExcludedValidators, addr)
+	}
+}
+
+// IsValidatorExcluded checks if the validator with the given address is excluded from the active validator set
+func (p *PolyBFTConfig) IsValidatorExcluded(addr types.Address) bool {
+	for _, excluded := range p.ExcludedValidators {
+		if excluded == addr {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isInitialValidator checks if the validator with the given address is part of the initial validator set.
+// Unlike ValidatorByAddress, it doesn't touch the validator index, so it is safe to call from Validate.
+func (p *PolyBFTConfig) isInitialValidator(addr types.Address) bool {
+	for _, v := range p.InitialValidatorSet {
+		if v != nil && v.Address == addr {
+			return true
+		}
+	}
+
+	return false
+}
+
+// HasDuplicateBLSKeys checks if any two initial validators have the same BLS public key
+func (p *PolyBFTConfig) HasDuplicateBLSKeys() bool {
+	return len(p.duplicateBLSKeyPairs()) > 0
+}
+
+// ValidateBLSKeys checks that the BLS public keys of the initial validators are well formed, that is hex encoded
+// (optionally with 0x prefix) keys of validator.BLSPublicKeySize bytes, which are valid G2 points.
+// Each malformed key is reported along with the validator address. Validators without the BLS key are not checked.
+func (p *PolyBFTConfig) ValidateBLSKeys() error {
+	var err error
+
+	for i, v := range p.InitialValidatorSet {
+		if v == nil || v.BlsKey == "" {
+			continue
+		}
+
+		if _, keyErr := v.UnmarshalBLSPublicKey(); keyErr != nil {
+			err = multierror.Append(err, fieldErrorf(fmt.Sprintf("initialValidatorSet[%d]", i),
+				"initial validator %s has malformed BLS public key: %w", v.Address, keyErr))
+		}
+	}
+
+	return err
+}
+
+// duplicateBLSKeyPairs returns the index pairs of the initial validators which have the same BLS public key,
+// in the order of the validator set. Validators without the BLS public key are not considered.
+func (p *PolyBFTConfig) duplicateBLSKeyPairs() [][2]int {
+	var pairs [][2]int
+
+	seen := make(map[string][]int, len(p.InitialValidatorSet))
+
+	for i, v := range p.InitialValidatorSet {
+		if v == nil || v.BlsKey == "" {
+			continue
+		}
+
+		key := strings.ToLower(strings.TrimPrefix(v.BlsKey, "0x"))
+		for _, j := range seen[key] {
+			pairs = append(pairs, [2]int{j, i})
+		}
+
+		seen[key] = append(seen[key], i)
+	}
+
+	return pairs
+}
+
+// ValidatorByAddress returns the initial validator with the given address.
+// The address index is built on the first call and reused by the subsequent calls. Each hit is checked
+// against the InitialValidatorSet and a miss falls back to the linear search, so the index is rebuilt
+// whenever the validator set is mutated (validators added, removed, reordered or replaced).
+// Since the index is built lazily, the method is not safe for concurrent use (see PolyBFTConfig).
+func (p *PolyBFTConfig) ValidatorByAddress(addr types.Address) (*validator.GenesisValidator, bool) {
+	if i, ok := p.validatorIndex[addr]; ok && i < len(p.InitialValidatorSet) {
+		if v := p.InitialValidatorSet[i]; v != nil && v.Address == addr {
+			return v, true
+		}
+	}
+
+	if p.validatorIndex != nil && len(p.validatorIndex) == len(p.InitialValidatorSet) {
+		// the index is up to date, unless the validator set was mutated in a way which keeps its size
+		for _, v := range p.InitialValidatorSet {
+			if v != nil && v.Address == addr {
+				p.buildValidatorIndex()
+
+				return v, true
+			}
+		}
+
+		return nil, false
+	}
+
+	p.buildValidatorIndex()
+
+	if i, ok := p.validatorIndex[addr]; ok {
+		return p.InitialValidatorSet[i], true
+	}
+
+	return nil, false
+}
+
+// buildValidatorIndex (re)builds the address index of the initial validators.
+// If an address is duplicated, its first occurrence is indexed.
+func (p *PolyBFTConfig) buildValidatorIndex() {
+	p.validatorIndex = make(map[types.Address]int, len(p.InitialValidatorSet))
+
+	for i, v := range p.InitialValidatorSet {
+		if v == nil {
+			continue
+		}
+
+		if _, exists := p.validatorIndex[v.Address]; !exists {
+			p.validatorIndex[v.Address] = i
+		}
+	}
+}
+
+// SetValidatorStake updates the initial stake of the genesis validator with the given address
+func (p *PolyBFTConfig) SetValidatorStake(addr types.Address, stake *big.Int) error {
+	if stake == nil || stake.Sign() < 0 {
+		return fmt.Errorf("invalid stake provided for validator %s: %v", addr, stake)
+	}
+
+	v, ok := p.ValidatorByAddress(addr)
+	if !ok {
+		return fmt.Errorf("validator %s is not part of the initial validator set", addr)
+	}
+
+	v.Stake = new(big.Int).Set(stake)
+
+	return nil
+}
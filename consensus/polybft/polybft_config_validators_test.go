@@ -0,0 +1,359 @@
+package polybft
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/validator"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPolyBFTConfig_SetValidatorStake(t *testing.T) {
+	t.Parallel()
+
+	addr := types.StringToAddress("1")
+	config := &PolyBFTConfig{
+		InitialValidatorSet: []*validator.GenesisValidator{
+			{Address: addr, Stake: big.NewInt(10)},
+			{Address: types.StringToAddress("2"), Stake: big.NewInt(10)},
+		},
+	}
+
+	stake := big.NewInt(50)
+	require.NoError(t, config.SetValidatorStake(addr, stake))
+	require.Equal(t, big.NewInt(50), config.InitialValidatorSet[0].Stake)
+	require.Equal(t, big.NewInt(10), config.InitialValidatorSet[1].Stake)
+
+	// stake is copied, so mutating the provided value doesn't affect the config
+	stake.SetInt64(1)
+	require.Equal(t, big.NewInt(50), config.InitialValidatorSet[0].Stake)
+
+	require.ErrorContains(t, config.SetValidatorStake(types.StringToAddress("3"), big.NewInt(1)),
+		"is not part of the initial validator set")
+	require.ErrorContains(t, config.SetValidatorStake(addr, big.NewInt(-1)), "invalid stake")
+	require.ErrorContains(t, config.SetValidatorStake(addr, nil), "invalid stake")
+}
+
+func TestPolyBFTConfig_ActiveValidatorCount(t *testing.T) {
+	t.Parallel()
+
+	validators := []*validator.GenesisValidator{{}, {}, {}}
+
+	cases := []struct {
+		name                string
+		maxValidatorSetSize uint64
+		expectedCount       uint64
+		expectedWarning     string
+	}{
+		{"under", 5, 3, ""},
+		{"equal", 3, 3, ""},
+		{"over", 2, 2, "1 validator(s) will be dropped from the active set"},
+	}
+
+	for _, c := range cases {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			config := &PolyBFTConfig{InitialValidatorSet: validators, MaxValidatorSetSize: c.maxValidatorSetSize}
+			require.Equal(t, c.expectedCount, config.ActiveValidatorCount())
+
+			warnings, _ := SplitValidationWarnings(config.Validate())
+			if c.expectedWarning == "" {
+				require.Empty(t, warnings)
+			} else {
+				require.Len(t, warnings, 1)
+				require.Contains(t, warnings[0].Message, c.expectedWarning)
+			}
+		})
+	}
+}
+
+func TestPolyBFTConfig_ExcludeValidator(t *testing.T) {
+	t.Parallel()
+
+	config := &PolyBFTConfig{
+		InitialValidatorSet: []*validator.GenesisValidator{
+			{Address: types.StringToAddress("1"), Balance: big.NewInt(1), Stake: big.NewInt(1)},
+			{Address: types.StringToAddress("2"), Balance: big.NewInt(1), Stake: big.NewInt(1)},
+			{Address: types.StringToAddress("3"), Balance: big.NewInt(1), Stake: big.NewInt(1)},
+		},
+		MaxValidatorSetSize: 2,
+	}
+
+	config.ExcludeValidator(types.StringToAddress("2"))
+	config.ExcludeValidator(types.StringToAddress("2"))
+
+	require.Equal(t, []types.Address{types.StringToAddress("2")}, config.ExcludedValidators)
+	require.True(t, config.IsValidatorExcluded(types.StringToAddress("2")))
+	require.False(t, config.IsValidatorExcluded(types.StringToAddress("1")))
+
+	// excluded validator stays in genesis, but it doesn't count against the max validator set size
+	require.Len(t, config.InitialValidatorSet, 3)
+	require.Equal(t, uint64(2), config.ActiveValidatorCount())
+
+	active := config.ActiveValidators()
+	require.Len(t, active, 2)
+	require.Equal(t, types.StringToAddress("1"), active[0].Address)
+	require.Equal(t, types.StringToAddress("3"), active[1].Address)
+
+	warnings, _ := SplitValidationWarnings(config.Validate())
+	for _, warning := range warnings {
+		require.NotContains(t, warning.Message, "will be dropped from the active set")
+	}
+
+	t.Run("unknown excluded validator", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := config.Copy()
+		cfg.ExcludeValidator(types.StringToAddress("4"))
+
+		warnings, _ := SplitValidationWarnings(cfg.Validate())
+		require.Len(t, warnings, 1)
+		require.Contains(t, warnings[0].Message, "is not part of the initial validator set")
+	})
+
+	t.Run("all validators excluded", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := config.Copy()
+		cfg.ExcludeValidator(types.StringToAddress("1"))
+		cfg.ExcludeValidator(types.StringToAddress("3"))
+
+		require.Zero(t, cfg.ActiveValidatorCount())
+		require.ErrorContains(t, cfg.Validate(), "all initial validators are excluded")
+	})
+
+	t.Run("JSON encoding", func(t *testing.T) {
+		t.Parallel()
+
+		data, err := json.Marshal(config)
+		require.NoError(t, err)
+
+		var decoded PolyBFTConfig
+
+		require.NoError(t, json.Unmarshal(data, &decoded))
+		require.Equal(t, config.ExcludedValidators, decoded.ExcludedValidators)
+	})
+}
+
+func TestPolyBFTConfig_MinValidatorStake(t *testing.T) {
+	t.Parallel()
+
+	config := MinimalValidPolyBFTConfig()
+	stake := config.InitialValidatorSet[0].Stake
+
+	// there is no minimum by default
+	require.True(t, config.MeetsMinStake(big.NewInt(0)))
+	require.True(t, config.MeetsMinStake(nil))
+
+	// validator exactly at the threshold meets it
+	config.MinValidatorStake = new(big.Int).Set(stake)
+	require.True(t, config.MeetsMinStake(stake))
+	require.False(t, config.MeetsMinStake(nil))
+	require.NoError(t, config.Validate())
+
+	data, err := json.Marshal(config)
+	require.NoError(t, err)
+	require.Contains(t, string(data), `"minValidatorStake":"0xde0b6b3a7640000"`)
+
+	var decoded PolyBFTConfig
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	require.True(t, config.Equal(&decoded))
+	require.Equal(t, stake, decoded.MinValidatorStake)
+
+	// validator just below the threshold doesn't meet it
+	config.MinValidatorStake = new(big.Int).Add(stake, big.NewInt(1))
+	require.False(t, config.MeetsMinStake(stake))
+	require.ErrorContains(t, config.Validate(), fmt.Sprintf("initial validator %s stake is below minValidatorStake "+
+		"(stake=%s, minValidatorStake=%s)", config.InitialValidatorSet[0].Address, stake, config.MinValidatorStake))
+
+	config.MinValidatorStake = big.NewInt(-1)
+	require.ErrorContains(t, config.Validate(), "minValidatorStake must not be negative (minValidatorStake=-1)")
+
+	// unset minimum is not encoded
+	data, err = json.Marshal(MinimalValidPolyBFTConfig())
+	require.NoError(t, err)
+	require.NotContains(t, string(data), "minValidatorStake")
+}
+
+func TestPolyBFTConfig_VotingPower(t *testing.T) {
+	t.Parallel()
+
+	addr := types.StringToAddress
+	config := &PolyBFTConfig{
+		MaxValidatorSetSize: 3,
+		InitialValidatorSet: []*validator.GenesisValidator{
+			{Address: addr("0x4"), Stake: big.NewInt(100)},
+			{Address: addr("0x3"), Stake: big.NewInt(100)},
+			{Address: addr("0x2"), Stake: big.NewInt(200)},
+			{Address: addr("0x1"), Stake: big.NewInt(100)},
+			{Address: addr("0x5"), Stake: big.NewInt(1000)},
+		},
+		ExcludedValidators: []types.Address{addr("0x5")},
+	}
+
+	// the excluded validator doesn't count, the tie of 0x1, 0x3 and 0x4 is broken by the lower address
+	require.Equal(t, map[types.Address]*big.Int{
+		addr("0x2"): big.NewInt(500_000_000_000_000_000),
+		addr("0x1"): big.NewInt(250_000_000_000_000_000),
+		addr("0x3"): big.NewInt(250_000_000_000_000_000),
+	}, config.VotingPower())
+
+	// the power is rounded down
+	config.MaxValidatorSetSize = 2
+	require.Equal(t, map[types.Address]*big.Int{
+		addr("0x2"): big.NewInt(666_666_666_666_666_666),
+		addr("0x1"): big.NewInt(333_333_333_333_333_333),
+	}, config.VotingPower())
+
+	// zero total stake results in zero voting power
+	config.InitialValidatorSet = []*validator.GenesisValidator{{Address: addr("0x1")}}
+	require.Equal(t, map[types.Address]*big.Int{addr("0x1"): big.NewInt(0)}, config.VotingPower())
+}
+
+func TestPolyBFTConfig_ValidatorByAddress(t *testing.T) {
+	t.Parallel()
+
+	addrs := []types.Address{types.StringToAddress("1"), types.StringToAddress("2"), types.StringToAddress("3")}
+	config := &PolyBFTConfig{
+		InitialValidatorSet: []*validator.GenesisValidator{
+			{Address: addrs[0], Stake: big.NewInt(1)},
+			{Address: addrs[1], Stake: big.NewInt(2)},
+		},
+	}
+
+	v, ok := config.ValidatorByAddress(addrs[1])
+	require.True(t, ok)
+	require.Same(t, config.InitialValidatorSet[1], v)
+
+	t.Run("Missing address", func(t *testing.T) {
+		v, ok := config.ValidatorByAddress(addrs[2])
+		require.False(t, ok)
+		require.Nil(t, v)
+	})
+
+	t.Run("Validator appended", func(t *testing.T) {
+		config.InitialValidatorSet = append(config.InitialValidatorSet,
+			&validator.GenesisValidator{Address: addrs[2], Stake: big.NewInt(3)})
+
+		v, ok := config.ValidatorByAddress(addrs[2])
+		require.True(t, ok)
+		require.Equal(t, big.NewInt(3), v.Stake)
+	})
+
+	t.Run("Validators reordered and replaced", func(t *testing.T) {
+		config.InitialValidatorSet[0], config.InitialValidatorSet[1] =
+			config.InitialValidatorSet[1], config.InitialValidatorSet[0]
+		config.InitialValidatorSet[2] = &validator.GenesisValidator{Address: types.StringToAddress("4")}
+
+		v, ok := config.ValidatorByAddress(addrs[0])
+		require.True(t, ok)
+		require.Same(t, config.InitialValidatorSet[1], v)
+
+		_, ok = config.ValidatorByAddress(addrs[2])
+		require.False(t, ok)
+
+		_, ok = config.ValidatorByAddress(types.StringToAddress("4"))
+		require.True(t, ok)
+	})
+
+	t.Run("Validator removed", func(t *testing.T) {
+		config.InitialValidatorSet = config.InitialValidatorSet[:1]
+
+		_, ok := config.ValidatorByAddress(addrs[0])
+		require.False(t, ok)
+
+		v, ok := config.ValidatorByAddress(addrs[1])
+		require.True(t, ok)
+		require.Same(t, config.InitialValidatorSet[0], v)
+	})
+}
+
+func TestPolyBFTConfig_ConcurrentValidate(t *testing.T) {
+	t.Parallel()
+
+	config := MinimalValidPolyBFTConfig()
+	config.ExcludedValidators = []types.Address{types.StringToAddress("0xdead")}
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			warnings, err := SplitValidationWarnings(config.Validate())
+			assert.NoError(t, err)
+			assert.Len(t, warnings, 1)
+			assert.NotEmpty(t, config.Lint())
+		}()
+	}
+
+	wg.Wait()
+
+	// validation doesn't build the validator index
+	require.Nil(t, config.validatorIndex)
+}
+
+func TestPolyBFTConfig_ValidateBLSKeys(t *testing.T) {
+	t.Parallel()
+
+	validators := validator.NewTestValidators(t, 4).GetParamValidators()
+
+	config := DefaultPolyBFTConfig()
+	config.InitialValidatorSet = validators
+	require.NoError(t, config.ValidateBLSKeys())
+
+	// prefixed and uppercase keys are well formed
+	validators[0].BlsKey = "0x" + strings.ToUpper(validators[0].BlsKey)
+	validators[1].BlsKey = ""
+	require.NoError(t, config.ValidateBLSKeys())
+
+	validators[2].BlsKey = validators[2].BlsKey[2:]
+	validators[3].BlsKey = "0xnothex"
+
+	err := config.ValidateBLSKeys()
+	require.ErrorContains(t, err, fmt.Sprintf("initial validator %s has malformed BLS public key: "+
+		"BLS public key must have 128 bytes, but it has 127 bytes", validators[2].Address))
+	require.ErrorContains(t, err, fmt.Sprintf("initial validator %s has malformed BLS public key: "+
+		"BLS public key is not hex encoded", validators[3].Address))
+	require.NotContains(t, err.Error(), validators[0].Address.String())
+
+	require.ErrorContains(t, config.Validate(), "has malformed BLS public key")
+}
+
+func TestPolyBFTConfig_DuplicateBLSKeys(t *testing.T) {
+	t.Parallel()
+
+	validators := validator.NewTestValidators(t, 4).GetParamValidators()
+
+	config := DefaultPolyBFTConfig()
+	config.InitialValidatorSet = validators
+	require.False(t, config.HasDuplicateBLSKeys())
+	require.NoError(t, config.Validate())
+
+	// validators 1 and 3 copied the key of validator 0 (with different casing)
+	validators[1].BlsKey = validators[0].BlsKey
+	validators[3].BlsKey = strings.ToUpper(validators[0].BlsKey)
+	require.True(t, config.HasDuplicateBLSKeys())
+
+	err := config.Validate()
+	for _, pair := range [][2]int{{0, 1}, {0, 3}, {1, 3}} {
+		require.ErrorContains(t, err, fmt.Sprintf("initial validators %s and %s have the same BLS public key",
+			validators[pair[0]].Address, validators[pair[1]].Address))
+	}
+
+	require.NotContains(t, err.Error(), validators[2].Address.String())
+
+	// validators without BLS keys are not reported
+	config.InitialValidatorSet = []*validator.GenesisValidator{{}, {}}
+	require.False(t, config.HasDuplicateBLSKeys())
+}
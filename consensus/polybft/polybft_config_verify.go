@@ -0,0 +1,301 @@
+package polybft
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/url"
+	"sort"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/contractsapi"
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/umbracle/ethgo"
+	"github.com/umbracle/ethgo/abi"
+	"github.com/umbracle/ethgo/jsonrpc"
+	"github.com/umbracle/ethgo/jsonrpc/codec"
+)
+
+// jsonRPCRequestTimeout bounds a single JSON RPC request sent to the HTTP endpoint
+const jsonRPCRequestTimeout = 30 * time.Second
+
+var (
+	// ErrRootchainUnreachable is returned when the rootchain of the configured bridge can not be reached
+	ErrRootchainUnreachable = errors.New("rootchain is unreachable")
+)
+
+// LoadAndVerifyPolyBFTConfig loads and validates the polybft config from provided chain config path.
+// If bridge is configured, it also dials every rootchain JSON RPC endpoint of both the legacy Bridge and
+// the Bridges (respecting the context deadline), to make sure they are reachable and
+// (for the Bridges, which are keyed by rootchain chain ID) that the chain ID matches.
+// The bridges to the custom (non-EVM) rootchains are not dialed.
+// Returned error wraps either one of the ErrConfigNotFound, ErrConfigMalformed and ErrConfigInvalid,
+// or ErrRootchainUnreachable.
+func LoadAndVerifyPolyBFTConfig(ctx context.Context, chainConfigFile string) (PolyBFTConfig, error) {
+	chainCfg, err := importChainConfig(chainConfigFile)
+	if err != nil {
+		return PolyBFTConfig{}, err
+	}
+
+	config, err := GetPolyBFTConfig(chainCfg, WithValidation())
+	if err != nil {
+		return PolyBFTConfig{}, err
+	}
+
+	if config.Bridge != nil {
+		// the legacy bridge isn't keyed by the rootchain chain ID, so only its reachability is verified
+		if err := verifyBridgeRootchain(ctx, config.Bridge, 0); err != nil {
+			return PolyBFTConfig{}, err
+		}
+	}
+
+	chainIDs := make([]uint64, 0, len(config.Bridges))
+	for chainID := range config.Bridges {
+		chainIDs = append(chainIDs, chainID)
+	}
+
+	sort.Slice(chainIDs, func(i, j int) bool { return chainIDs[i] < chainIDs[j] })
+
+	for _, chainID := range chainIDs {
+		if bridge := config.Bridges[chainID]; bridge != nil {
+			if err := verifyBridgeRootchain(ctx, bridge, chainID); err != nil {
+				return PolyBFTConfig{}, err
+			}
+		}
+	}
+
+	return config, nil
+}
+
+// VerifyAgainstChain compares the rootchain contracts deployed at JSONRPCAddr with the config.
+// It checks that the checkpoint manager and the stake manager contracts are deployed and responsive,
+// and that the BLS and BN256G2 addresses the checkpoint manager was initialized with match the config.
+// Discrepancies are returned as changes from the configured (Old) to the on-chain (New) values, while contracts
+// which can't be queried are reported as changes to the "unreachable: <reason>" value. Error is returned
+// only if the rootchain itself is unreachable, in which case it wraps ErrRootchainUnreachable.
+func (r *RootchainConfig) VerifyAgainstChain(ctx context.Context) ([]ConfigChange, error) {
+	var changes []ConfigChange
+
+	err := callJSONRPC(ctx, r.JSONRPCAddr, func(rpc jsonRPCCallFn) error {
+		var chainID string
+		if err := rpc("eth_chainId", &chainID); err != nil {
+			return err
+		}
+
+		changes = r.verifyContracts(rpc)
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s: %v", ErrRootchainUnreachable, r.JSONRPCAddr, err)
+	}
+
+	return changes, nil
+}
+
+// verifyContracts compares the checkpoint manager and the stake manager contracts state with the config
+func (r *RootchainConfig) verifyContracts(rpc jsonRPCCallFn) []ConfigChange {
+	var changes []ConfigChange
+
+	unreachable := func(field string, addr types.Address, err error) {
+		changes = append(changes, ConfigChange{Field: field, Old: addr.String(), New: "unreachable: " + err.Error()})
+	}
+
+	if r.CheckpointManagerAddress != types.ZeroAddress {
+		addressGetters := []struct {
+			method   string
+			expected types.Address
+		}{
+			{"bls", r.BLSAddress},
+			{"bn256G2", r.BN256G2Address},
+		}
+
+		for _, getter := range addressGetters {
+			field := "checkpointManager." + getter.method
+
+			output, err := callRootchainGetter(rpc, r.CheckpointManagerAddress, contractsapi.CheckpointManager.Abi,
+				getter.method)
+			if err != nil {
+				unreachable(field, r.CheckpointManagerAddress, err)
+
+				continue
+			}
+
+			if actual := types.BytesToAddress(output[12:32]); actual != getter.expected {
+				changes = append(changes, ConfigChange{Field: field, Old: getter.expected.String(), New: actual.String()})
+			}
+		}
+	}
+
+	if r.StakeManagerAddress != types.ZeroAddress {
+		if _, err := callRootchainGetter(rpc, r.StakeManagerAddress, contractsapi.StakeManager.Abi,
+			"totalStake"); err != nil {
+			unreachable("stakeManager.totalStake", r.StakeManagerAddress, err)
+		}
+	}
+
+	return changes
+}
+
+// callRootchainGetter calls the getter without arguments of the rootchain contract and returns its raw output,
+// which is guaranteed to hold at least a single ABI word
+func callRootchainGetter(rpc jsonRPCCallFn, contract types.Address, contractABI *abi.ABI,
+	method string) ([]byte, error) {
+	getter := contractABI.GetMethod(method)
+	if getter == nil {
+		return nil, fmt.Errorf("method %s is not part of the contract ABI", method)
+	}
+
+	var code string
+	if err := rpc("eth_getCode", &code, ethgo.Address(contract), ethgo.Latest.String()); err != nil {
+		return nil, err
+	}
+
+	if code == "" || code == "0x" {
+		return nil, fmt.Errorf("no contract deployed at %s", contract)
+	}
+
+	to := ethgo.Address(contract)
+
+	var response string
+	if err := rpc("eth_call", &response, &ethgo.CallMsg{To: &to, Data: getter.ID()}, ethgo.Latest.String()); err != nil {
+		return nil, fmt.Errorf("failed to call %s: %w", method, err)
+	}
+
+	output, err := hex.DecodeHex(response)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode %s response: %w", method, err)
+	}
+
+	if len(output) < types.HashLength {
+		return nil, fmt.Errorf("unexpected %s response length %d", method, len(output))
+	}
+
+	return output, nil
+}
+
+// verifyBridgeRootchain dials every JSON RPC endpoint of the bridge, to make sure it is reachable and,
+// unless the expected chain ID is 0, that it serves the rootchain with the expected chain ID.
+// The custom (non-EVM) rootchain doesn't expose the EVM JSON RPC, so it is left to its adapter.
+func verifyBridgeRootchain(ctx context.Context, bridge *BridgeConfig, expectedChainID uint64) error {
+	if !bridge.IsEVMRootchain() {
+		return nil
+	}
+
+	for _, endpoint := range bridge.Endpoints() {
+		rootchainID, err := getRootchainChainID(ctx, endpoint)
+		if err != nil {
+			return fmt.Errorf("%w: %s: %v", ErrRootchainUnreachable, endpoint, err)
+		}
+
+		if expectedChainID != 0 && (!rootchainID.IsUint64() || rootchainID.Uint64() != expectedChainID) {
+			return fmt.Errorf("%w: rootchain at %s has chain ID %s, but it is configured for chain ID %d",
+				ErrConfigInvalid, endpoint, rootchainID, expectedChainID)
+		}
+	}
+
+	return nil
+}
+
+// getRootchainChainID queries the chain ID of the rootchain exposed at provided JSON RPC endpoint.
+// It returns as soon as the context is done, even if the request is still in flight.
+func getRootchainChainID(ctx context.Context, endpoint string) (*big.Int, error) {
+	var chainID string
+
+	if err := callJSONRPC(ctx, endpoint, func(rpc jsonRPCCallFn) error {
+		return rpc("eth_chainId", &chainID)
+	}); err != nil {
+		return nil, err
+	}
+
+	return types.ParseUint256orHex(&chainID)
+}
+
+// jsonRPCCallFn performs the JSON RPC call of the given method, decoding its result into out
+type jsonRPCCallFn func(method string, out interface{}, params ...interface{}) error
+
+// callJSONRPC runs the call against provided JSON RPC endpoint and returns as soon as the context is done.
+// The requests to the HTTP endpoints are bound to the context (and to the jsonRPCRequestTimeout), so they are
+// aborted together with it. The other endpoints (e.g. websocket) are served by the jsonrpc client,
+// which is closed once the context is done, so that the in-flight call fails instead of outliving it.
+// The results captured by the call are safe to read only if it returns nil error.
+func callJSONRPC(ctx context.Context, endpoint string, call func(rpc jsonRPCCallFn) error) error {
+	if u, err := url.Parse(endpoint); err == nil && (u.Scheme == "http" || u.Scheme == "https") {
+		httpClient := &http.Client{Timeout: jsonRPCRequestTimeout}
+
+		return call(func(method string, out interface{}, params ...interface{}) error {
+			return callHTTPJSONRPC(ctx, httpClient, endpoint, method, out, params...)
+		})
+	}
+
+	client, err := jsonrpc.NewClient(endpoint)
+	if err != nil {
+		return err
+	}
+
+	errCh := make(chan error, 1)
+
+	go func() {
+		errCh <- call(client.Call)
+	}()
+
+	select {
+	case <-ctx.Done():
+		_ = client.Close()
+
+		return ctx.Err()
+	case err := <-errCh:
+		_ = client.Close()
+
+		return err
+	}
+}
+
+// callHTTPJSONRPC sends the JSON RPC request to provided HTTP endpoint within the context
+func callHTTPJSONRPC(ctx context.Context, httpClient *http.Client, endpoint, method string,
+	out interface{}, params ...interface{}) error {
+	request := codec.Request{JsonRPC: "2.0", ID: 1, Method: method}
+
+	if len(params) > 0 {
+		data, err := json.Marshal(params)
+		if err != nil {
+			return err
+		}
+
+		request.Params = data
+	}
+
+	body, err := json.Marshal(request)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	var response codec.Response
+	if err := json.NewDecoder(res.Body).Decode(&response); err != nil {
+		return fmt.Errorf("failed to decode %s response (status=%s): %w", method, res.Status, err)
+	}
+
+	if response.Error != nil {
+		return response.Error
+	}
+
+	return json.Unmarshal(response.Result, out)
+}
@@ -0,0 +1,273 @@
+package polybft
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/contractsapi"
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPolyBFTConfig_LoadAndVerifyPolyBFTConfig(t *testing.T) {
+	t.Parallel()
+
+	newRootchain := func(t *testing.T, chainID uint64) *httptest.Server {
+		t.Helper()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprintf(w, `{"jsonrpc":"2.0","id":1,"result":"0x%x"}`, chainID)
+		}))
+		t.Cleanup(server.Close)
+
+		return server
+	}
+
+	writeChainConfig := func(t *testing.T, bridges map[uint64]*BridgeConfig) string {
+		t.Helper()
+
+		polyBFTConfig := MinimalValidPolyBFTConfig()
+		polyBFTConfig.Bridges = bridges
+
+		path := filepath.Join(t.TempDir(), "genesis.json")
+		writeTestChainConfig(t, path, 100, &polyBFTConfig)
+
+		return path
+	}
+
+	newBridge := func(endpoint string) *BridgeConfig {
+		bridge := newTestBridgeConfig()
+		bridge.JSONRPCEndpoint = endpoint
+
+		return bridge
+	}
+
+	t.Run("rootchain reachable with matching chain ID", func(t *testing.T) {
+		t.Parallel()
+
+		rootchain := newRootchain(t, 5)
+		path := writeChainConfig(t, map[uint64]*BridgeConfig{5: newBridge(rootchain.URL)})
+
+		config, err := LoadAndVerifyPolyBFTConfig(context.Background(), path)
+		require.NoError(t, err)
+		require.Equal(t, int64(100), config.GetChainID())
+	})
+
+	t.Run("rootchain chain ID mismatch", func(t *testing.T) {
+		t.Parallel()
+
+		rootchain := newRootchain(t, 7)
+		path := writeChainConfig(t, map[uint64]*BridgeConfig{5: newBridge(rootchain.URL)})
+
+		_, err := LoadAndVerifyPolyBFTConfig(context.Background(), path)
+		require.ErrorIs(t, err, ErrConfigInvalid)
+	})
+
+	t.Run("fallback endpoint chain ID mismatch", func(t *testing.T) {
+		t.Parallel()
+
+		bridge := newBridge(newRootchain(t, 5).URL)
+		bridge.JSONRPCEndpoints = []string{newRootchain(t, 7).URL}
+
+		_, err := LoadAndVerifyPolyBFTConfig(context.Background(), writeChainConfig(t, map[uint64]*BridgeConfig{5: bridge}))
+		require.ErrorIs(t, err, ErrConfigInvalid)
+		require.ErrorContains(t, err, bridge.JSONRPCEndpoints[0])
+	})
+
+	t.Run("rootchain unreachable", func(t *testing.T) {
+		t.Parallel()
+
+		rootchain := newRootchain(t, 5)
+		rootchain.Close()
+
+		path := writeChainConfig(t, map[uint64]*BridgeConfig{5: newBridge(rootchain.URL)})
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		_, err := LoadAndVerifyPolyBFTConfig(ctx, path)
+		require.ErrorIs(t, err, ErrRootchainUnreachable)
+	})
+
+	t.Run("custom rootchain is not dialed", func(t *testing.T) {
+		t.Parallel()
+
+		unreachable := newRootchain(t, 9)
+		unreachable.Close()
+
+		polyBFTConfig := MinimalValidPolyBFTConfig()
+		polyBFTConfig.Bridge = newBridge(newRootchain(t, 5).URL)
+		polyBFTConfig.Bridges = map[uint64]*BridgeConfig{9: {
+			JSONRPCEndpoint:    unreachable.URL,
+			CheckpointInterval: 1,
+			MaxBridgeBatchSize: maxCommitmentSize,
+			RootchainKind:      RootchainKindCustom,
+			Extra:              map[string]string{"network": "mainnet"},
+		}}
+
+		path := filepath.Join(t.TempDir(), "genesis.json")
+		writeTestChainConfig(t, path, 100, &polyBFTConfig)
+
+		_, err := LoadAndVerifyPolyBFTConfig(context.Background(), path)
+		require.NoError(t, err)
+	})
+
+	t.Run("invalid config", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := LoadAndVerifyPolyBFTConfig(context.Background(), filepath.Join(t.TempDir(), "missing.json"))
+		require.ErrorIs(t, err, ErrConfigNotFound)
+		require.NotErrorIs(t, err, ErrConfigInvalid)
+	})
+}
+
+func TestCallJSONRPC_AbortsRequestOnContextDone(t *testing.T) {
+	t.Parallel()
+
+	aborted := make(chan struct{})
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// the client disconnect is noticed only once the request body is consumed
+		_, _ = io.Copy(io.Discard, r.Body)
+
+		select {
+		case <-r.Context().Done():
+			close(aborted)
+		case <-time.After(5 * time.Second):
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	err := callJSONRPC(ctx, server.URL, func(rpc jsonRPCCallFn) error {
+		var chainID string
+
+		return rpc("eth_chainId", &chainID)
+	})
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	select {
+	case <-aborted:
+	case <-time.After(5 * time.Second):
+		t.Fatal("request was not aborted")
+	}
+}
+
+func TestRootchainConfig_VerifyAgainstChain(t *testing.T) {
+	t.Parallel()
+
+	var (
+		checkpointManager = types.StringToAddress("10")
+		stakeManager      = types.StringToAddress("11")
+		blsAddr           = types.StringToAddress("12")
+		bn256G2Addr       = types.StringToAddress("13")
+	)
+
+	// newRootchain serves the checkpoint manager initialized with the given BN256G2 address.
+	// Stake manager is deployed only if stakeManagerDeployed is set.
+	newRootchain := func(t *testing.T, deployedBN256G2 types.Address, stakeManagerDeployed bool) *httptest.Server {
+		t.Helper()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var req struct {
+				Method string            `json:"method"`
+				Params []json.RawMessage `json:"params"`
+			}
+
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+
+			result := "0x"
+
+			switch req.Method {
+			case "eth_chainId":
+				result = "0x5"
+			case "eth_getCode":
+				var addr types.Address
+				require.NoError(t, json.Unmarshal(req.Params[0], &addr))
+
+				if addr == checkpointManager || (addr == stakeManager && stakeManagerDeployed) {
+					result = "0x6080"
+				}
+			case "eth_call":
+				var msg struct {
+					Data string `json:"data"`
+				}
+				require.NoError(t, json.Unmarshal(req.Params[0], &msg))
+
+				abi := contractsapi.CheckpointManager.Abi
+				switch msg.Data {
+				case hex.EncodeToHex(abi.GetMethod("bls").ID()):
+					result = hex.EncodeToHex(types.BytesToHash(blsAddr.Bytes()).Bytes())
+				case hex.EncodeToHex(abi.GetMethod("bn256G2").ID()):
+					result = hex.EncodeToHex(types.BytesToHash(deployedBN256G2.Bytes()).Bytes())
+				default:
+					result = hex.EncodeToHex(types.ZeroHash.Bytes())
+				}
+			}
+
+			fmt.Fprintf(w, `{"jsonrpc":"2.0","id":1,"result":%q}`, result)
+		}))
+		t.Cleanup(server.Close)
+
+		return server
+	}
+
+	newConfig := func(endpoint string) *RootchainConfig {
+		return &RootchainConfig{
+			JSONRPCAddr:              endpoint,
+			CheckpointManagerAddress: checkpointManager,
+			StakeManagerAddress:      stakeManager,
+			BLSAddress:               blsAddr,
+			BN256G2Address:           bn256G2Addr,
+		}
+	}
+
+	t.Run("matching deployment", func(t *testing.T) {
+		t.Parallel()
+
+		changes, err := newConfig(newRootchain(t, bn256G2Addr, true).URL).VerifyAgainstChain(context.Background())
+		require.NoError(t, err)
+		require.Empty(t, changes)
+	})
+
+	t.Run("drift and undeployed contract", func(t *testing.T) {
+		t.Parallel()
+
+		deployedBN256G2 := types.StringToAddress("14")
+
+		changes, err := newConfig(newRootchain(t, deployedBN256G2, false).URL).VerifyAgainstChain(context.Background())
+		require.NoError(t, err)
+		require.Len(t, changes, 2)
+		require.Equal(t, ConfigChange{
+			Field: "checkpointManager.bn256G2",
+			Old:   bn256G2Addr.String(),
+			New:   deployedBN256G2.String(),
+		}, changes[0])
+		require.Equal(t, "stakeManager.totalStake", changes[1].Field)
+		require.Equal(t, stakeManager.String(), changes[1].Old)
+		require.Equal(t, "unreachable: no contract deployed at "+stakeManager.String(), changes[1].New)
+	})
+
+	t.Run("rootchain unreachable", func(t *testing.T) {
+		t.Parallel()
+
+		rootchain := newRootchain(t, bn256G2Addr, true)
+		rootchain.Close()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		_, err := newConfig(rootchain.URL).VerifyAgainstChain(ctx)
+		require.ErrorIs(t, err, ErrRootchainUnreachable)
+	})
+}
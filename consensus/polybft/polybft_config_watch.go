@@ -0,0 +1,172 @@
+package polybft
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/hashicorp/go-hclog"
+)
+
+// defaultConfigWatchDebounce is the default period the watched config file has to stay unchanged
+// before it is reloaded
+const defaultConfigWatchDebounce = time.Second
+
+// ConfigWatchOption customizes the way WatchPolyBFTConfig reacts to the config file changes
+type ConfigWatchOption func(*configWatchOptions)
+
+type configWatchOptions struct {
+	debounce time.Duration
+}
+
+// WithDebounce sets the period the watched config file has to stay unchanged before it is reloaded
+func WithDebounce(debounce time.Duration) ConfigWatchOption {
+	return func(o *configWatchOptions) {
+		o.debounce = debounce
+	}
+}
+
+// WatchPolyBFTConfig watches the chain config at the given path and reloads (and validates) the polybft config
+// whenever the file changes. Each reload emits either the new config or the load (validation) error.
+// Rapid writes are debounced, that is the config is reloaded once the file stops changing.
+//
+// The parent directory of the file is watched (using fsnotify), so the file replaced on save
+// (written to a temporary file and renamed, as many editors do) is still detected.
+//
+// Each channel buffers only the latest value, which replaces the older one the consumer hasn't received yet,
+// so the watcher never blocks on the consumer (e.g. the one receiving only the configs).
+// Both channels are closed once the context is done. If the watch can't be started
+// (e.g. the parent directory doesn't exist), the error is emitted and both channels are closed.
+func WatchPolyBFTConfig(ctx context.Context, path string,
+	opts ...ConfigWatchOption) (<-chan PolyBFTConfig, <-chan error) {
+	options := configWatchOptions{
+		debounce: defaultConfigWatchDebounce,
+	}
+
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	configs := make(chan PolyBFTConfig, 1)
+	errs := make(chan error, 1)
+
+	watcher, err := newConfigFileWatcher(path, options)
+	if err != nil {
+		errs <- err
+
+		close(configs)
+		close(errs)
+
+		return configs, errs
+	}
+
+	path = filepath.Clean(path)
+
+	go func() {
+		defer close(configs)
+		defer close(errs)
+		defer watcher.Close()
+
+		// reload fires once the debounce period elapses after the last change,
+		// each change replaces it, so the pending reloads of the previous changes are dropped
+		var reload <-chan time.Time
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event, ok := <-watcher.Events:
+				if !ok {
+					return
+				}
+
+				if filepath.Clean(event.Name) != path || event.Op == fsnotify.Chmod {
+					continue
+				}
+
+				reload = time.After(options.debounce)
+			case err, ok := <-watcher.Errors:
+				if !ok {
+					return
+				}
+
+				emitWatchedError(errs, fmt.Errorf("failed to watch config %s: %w", path, err))
+			case <-reload:
+				reload = nil
+
+				config, err := loadWatchedConfig(path)
+				if err != nil {
+					emitWatchedError(errs, err)
+
+					continue
+				}
+
+				emitWatchedConfig(configs, config)
+			}
+		}
+	}()
+
+	return configs, errs
+}
+
+// newConfigFileWatcher validates the watch options and starts watching the parent directory of the config file
+func newConfigFileWatcher(path string, options configWatchOptions) (*fsnotify.Watcher, error) {
+	if options.debounce < 0 {
+		return nil, fmt.Errorf("config watch debounce must not be negative: %s", options.debounce)
+	}
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create config watcher: %w", err)
+	}
+
+	if err := watcher.Add(filepath.Dir(path)); err != nil {
+		_ = watcher.Close()
+
+		return nil, fmt.Errorf("failed to watch config %s: %w", path, err)
+	}
+
+	return watcher, nil
+}
+
+// emitWatchedConfig sends the config to the channel, replacing the previous one if it hasn't been received yet
+func emitWatchedConfig(configs chan PolyBFTConfig, config PolyBFTConfig) {
+	select {
+	case configs <- config:
+	default:
+		select {
+		case <-configs:
+		default:
+		}
+
+		// the watcher is the only sender, hence there is room for the config now
+		configs <- config
+	}
+}
+
+// emitWatchedError sends the error to the channel, replacing the previous one if it hasn't been received yet
+func emitWatchedError(errs chan error, err error) {
+	select {
+	case errs <- err:
+	default:
+		select {
+		case <-errs:
+		default:
+		}
+
+		// the watcher is the only sender, hence there is room for the error now
+		errs <- err
+	}
+}
+
+// loadWatchedConfig loads and validates the polybft config of the watched chain config
+func loadWatchedConfig(path string) (PolyBFTConfig, error) {
+	chainCfg, err := importChainConfig(path)
+	if err != nil {
+		return PolyBFTConfig{}, err
+	}
+
+	return GetPolyBFTConfig(chainCfg, WithValidation(), WithLogger(hclog.NewNullLogger()))
+}
@@ -0,0 +1,162 @@
+package polybft
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWatchPolyBFTConfig(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "genesis.json")
+
+	writeConfig := func(config PolyBFTConfig) {
+		writeTestChainConfig(t, path, 100, &config)
+	}
+
+	config := MinimalValidPolyBFTConfig()
+	writeConfig(config)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	configs, errs := WatchPolyBFTConfig(ctx, path, WithDebounce(50*time.Millisecond))
+
+	// rapid writes result in a single reload of the last written config
+	for _, epochSize := range []uint64{20, 30, 40} {
+		config.EpochSize = epochSize
+		writeConfig(config)
+	}
+
+	select {
+	case reloaded := <-configs:
+		require.Equal(t, uint64(40), reloaded.EpochSize)
+	case err := <-errs:
+		require.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("config was not reloaded")
+	}
+
+	select {
+	case reloaded := <-configs:
+		t.Fatalf("unexpected reload of config with epoch size %d", reloaded.EpochSize)
+	case <-time.After(200 * time.Millisecond):
+	}
+
+	// invalid config is reported as an error
+	config.EpochSize = 0
+	writeConfig(config)
+
+	select {
+	case <-configs:
+		t.Fatal("invalid config was emitted")
+	case err := <-errs:
+		require.ErrorIs(t, err, ErrConfigInvalid)
+	case <-time.After(5 * time.Second):
+		t.Fatal("config was not reloaded")
+	}
+
+	// channels are closed once the context is cancelled
+	cancel()
+
+	require.Eventually(t, func() bool {
+		_, configsOpen := <-configs
+		_, errsOpen := <-errs
+
+		return !configsOpen && !errsOpen
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestWatchPolyBFTConfig_UnreceivedErrorsDontBlock(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "genesis.json")
+
+	writeConfig := func(config PolyBFTConfig) {
+		writeTestChainConfig(t, path, 100, &config)
+	}
+
+	config := MinimalValidPolyBFTConfig()
+	writeConfig(config)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// the consumer receives only the configs
+	configs, _ := WatchPolyBFTConfig(ctx, path, WithDebounce(50*time.Millisecond))
+
+	for _, epochSize := range []uint64{0, 20} {
+		config.EpochSize = epochSize
+		writeConfig(config)
+
+		// let the watcher reload the config before the next write
+		time.Sleep(300 * time.Millisecond)
+	}
+
+	select {
+	case reloaded := <-configs:
+		require.Equal(t, uint64(20), reloaded.EpochSize)
+	case <-time.After(5 * time.Second):
+		t.Fatal("watcher is blocked by the unreceived error")
+	}
+}
+
+func TestWatchPolyBFTConfig_ReplacedFile(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "genesis.json")
+
+	config := MinimalValidPolyBFTConfig()
+	writeTestChainConfig(t, path, 100, &config)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	configs, errs := WatchPolyBFTConfig(ctx, path, WithDebounce(50*time.Millisecond))
+
+	// editors commonly write the new content to a temporary file and rename it over the original one
+	config.EpochSize = 20
+	tmpPath := filepath.Join(dir, "genesis.json.tmp")
+	writeTestChainConfig(t, tmpPath, 100, &config)
+	require.NoError(t, os.Rename(tmpPath, path))
+
+	select {
+	case reloaded := <-configs:
+		require.Equal(t, uint64(20), reloaded.EpochSize)
+	case err := <-errs:
+		require.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("replaced config was not reloaded")
+	}
+}
+
+func TestWatchPolyBFTConfig_InvalidWatch(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name string
+		path string
+		opts []ConfigWatchOption
+	}{
+		{"negative debounce", filepath.Join(t.TempDir(), "genesis.json"), []ConfigWatchOption{WithDebounce(-time.Second)}},
+		{"missing directory", filepath.Join(t.TempDir(), "missing", "genesis.json"), nil},
+	}
+
+	for _, c := range cases {
+		configs, errs := WatchPolyBFTConfig(context.Background(), c.path, c.opts...)
+
+		require.Error(t, <-errs, c.name)
+
+		_, configsOpen := <-configs
+		_, errsOpen := <-errs
+
+		require.False(t, configsOpen, c.name)
+		require.False(t, errsOpen, c.name)
+	}
+}
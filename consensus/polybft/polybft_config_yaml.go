@@ -0,0 +1,110 @@
+package polybft
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+)
+
+// LoadPolyBFTConfigFromYAML loads YAML encoded chain config from provided path and unmarshals PolyBFTConfig
+func LoadPolyBFTConfigFromYAML(chainConfigFile string) (PolyBFTConfig, int64, error) {
+	data, err := readConfigFile(chainConfigFile)
+	if err != nil {
+		return PolyBFTConfig{}, 0, err
+	}
+
+	var node yaml.Node
+	if err := yaml.Unmarshal(data, &node); err != nil {
+		return PolyBFTConfig{}, 0, newConfigLoadError(ErrConfigMalformed, err)
+	}
+
+	jsonData, err := yamlNodeToJSON(&node)
+	if err != nil {
+		return PolyBFTConfig{}, 0, newConfigLoadError(ErrConfigMalformed, err)
+	}
+
+	return LoadPolyBFTConfigFromReader(bytes.NewReader(jsonData))
+}
+
+// MarshalYAML encodes PolyBFTConfig to YAML, using the same representation of values as JSON encoding
+func (p PolyBFTConfig) MarshalYAML() (interface{}, error) {
+	data, err := json.Marshal(p)
+	if err != nil {
+		return nil, err
+	}
+
+	// JSON is a subset of YAML, so it can be decoded directly into the YAML node tree,
+	// which keeps fields order and raw values (such as hex encoded numbers) intact
+	var node yaml.Node
+	if err := yaml.Unmarshal(data, &node); err != nil {
+		return nil, err
+	}
+
+	if len(node.Content) == 0 {
+		return nil, fmt.Errorf("failed to encode polybft config to YAML")
+	}
+
+	resetYAMLStyle(node.Content[0])
+
+	return node.Content[0], nil
+}
+
+// UnmarshalYAML decodes YAML encoded PolyBFTConfig, accepting the same representation of values as JSON decoding
+func (p *PolyBFTConfig) UnmarshalYAML(value *yaml.Node) error {
+	data, err := yamlNodeToJSON(value)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(data, p)
+}
+
+// yamlNodeToJSON converts provided YAML node to its JSON representation
+func yamlNodeToJSON(node *yaml.Node) ([]byte, error) {
+	var raw interface{}
+	if err := node.Decode(&raw); err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(normalizeYAMLValue(raw))
+}
+
+// normalizeYAMLValue converts maps with non-string keys (e.g. numeric keys) to maps with string keys,
+// so that the decoded YAML value can be encoded to JSON
+func normalizeYAMLValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, item := range v {
+			v[key] = normalizeYAMLValue(item)
+		}
+
+		return v
+	case map[interface{}]interface{}:
+		result := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			result[fmt.Sprint(key)] = normalizeYAMLValue(item)
+		}
+
+		return result
+	case []interface{}:
+		for i, item := range v {
+			v[i] = normalizeYAMLValue(item)
+		}
+
+		return v
+	default:
+		return v
+	}
+}
+
+// resetYAMLStyle switches the node tree from JSON-like flow style to the default block style.
+// Scalars keep their tags, so the values which would otherwise be ambiguous are still quoted.
+func resetYAMLStyle(node *yaml.Node) {
+	node.Style = 0
+
+	for _, child := range node.Content {
+		resetYAMLStyle(child)
+	}
+}
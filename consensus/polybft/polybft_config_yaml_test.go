@@ -0,0 +1,77 @@
+package polybft
+
+import (
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/validator"
+	"github.com/0xPolygon/polygon-edge/helper/common"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func TestPolyBFTConfig_YAMLRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	stateSenderAddr := types.StringToAddress("10")
+	original := &PolyBFTConfig{
+		InitialValidatorSet: []*validator.GenesisValidator{
+			{Address: types.StringToAddress("1"), Balance: big.NewInt(100), Stake: big.NewInt(10), BlsKey: "bls"},
+		},
+		Bridge: &BridgeConfig{
+			StateSenderAddr:         stateSenderAddr,
+			JSONRPCEndpoint:         "http://127.0.0.1:8545",
+			EventTrackerStartBlocks: map[types.Address]uint64{stateSenderAddr: 5},
+		},
+		Bridges:    map[uint64]*BridgeConfig{5: {JSONRPCEndpoint: "http://127.0.0.1:9545"}},
+		EpochSize:  10,
+		SprintSize: 5,
+		BlockTime:  common.Duration{Duration: 2 * time.Second},
+		RewardConfig: &RewardsConfig{
+			TokenAddress:  types.StringToAddress("2"),
+			WalletAddress: types.StringToAddress("3"),
+			WalletAmount:  big.NewInt(1000),
+		},
+	}
+
+	data, err := yaml.Marshal(original)
+	require.NoError(t, err)
+	require.Contains(t, string(data), "rewardWalletAmount: \"0x3e8\"")
+	require.Contains(t, string(data), "address: \""+types.StringToAddress("1").String()+"\"")
+
+	var decoded PolyBFTConfig
+
+	require.NoError(t, yaml.Unmarshal(data, &decoded))
+	require.Equal(t, original, &decoded)
+}
+
+func TestPolyBFTConfig_LoadPolyBFTConfigFromYAML(t *testing.T) {
+	t.Parallel()
+
+	chainYAML := `
+params:
+  chainID: 100
+  engine:
+    polybft:
+      epochSize: 10
+      sprintSize: 5
+      blockTime: 2s
+      bridges:
+        5:
+          jsonRPCEndpoint: http://127.0.0.1:9545
+`
+
+	path := filepath.Join(t.TempDir(), "genesis.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(chainYAML), 0600))
+
+	config, chainID, err := LoadPolyBFTConfigFromYAML(path)
+	require.NoError(t, err)
+	require.Equal(t, int64(100), chainID)
+	require.Equal(t, uint64(10), config.EpochSize)
+	require.Equal(t, 2*time.Second, config.BlockTime.Duration)
+	require.Equal(t, "http://127.0.0.1:9545", config.BridgeForChain(5).JSONRPCEndpoint)
+}
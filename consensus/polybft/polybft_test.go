@@ -2,13 +2,17 @@ package polybft
 
 import (
 	"errors"
+	"math/big"
 	"testing"
 	"time"
 
+	"github.com/0xPolygon/polygon-edge/chain"
 	"github.com/0xPolygon/polygon-edge/consensus"
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/contractsapi"
 	bls "github.com/0xPolygon/polygon-edge/consensus/polybft/signer"
 	"github.com/0xPolygon/polygon-edge/consensus/polybft/validator"
 	"github.com/0xPolygon/polygon-edge/consensus/polybft/wallet"
+	"github.com/0xPolygon/polygon-edge/contracts"
 	"github.com/0xPolygon/polygon-edge/helper/progress"
 	"github.com/0xPolygon/polygon-edge/txpool"
 	"github.com/0xPolygon/polygon-edge/types"
@@ -253,7 +257,8 @@ func Test_Factory(t *testing.T) {
 		Logger: hclog.Default(),
 		Config: &consensus.Config{
 			Config: map[string]interface{}{
-				"EpochSize": epochSize,
+				"EpochSize":  epochSize,
+				"SprintSize": 1,
 			},
 		},
 	}
@@ -270,3 +275,71 @@ func Test_Factory(t *testing.T) {
 	assert.Equal(t, epochSize, polybft.consensusConfig.EpochSize)
 	assert.Equal(t, params, polybft.config)
 }
+
+func Test_Factory_ZeroSprintSize(t *testing.T) {
+	t.Parallel()
+
+	params := &consensus.Params{
+		TxPool: &txpool.TxPool{},
+		Logger: hclog.NewNullLogger(),
+		Config: &consensus.Config{
+			Config: map[string]interface{}{
+				"EpochSize": 10,
+			},
+		},
+	}
+
+	_, err := Factory(params)
+	require.ErrorIs(t, err, ErrZeroSprintSize)
+}
+
+func TestGenesisPostHookFactory_RewardsDisabled(t *testing.T) {
+	t.Parallel()
+
+	// rewards are disabled by the missing reward config
+	config := MinimalValidPolyBFTConfig()
+	require.Nil(t, config.RewardConfig)
+	require.NoError(t, config.Validate())
+
+	chainConfig := &chain.Chain{
+		Params: &chain.Params{Engine: map[string]interface{}{ConsensusName: config}},
+	}
+
+	alloc := map[types.Address]*chain.GenesisAccount{}
+	for addr, artifact := range map[types.Address][]byte{
+		contracts.ValidatorSetContract:          contractsapi.ValidatorSet.DeployedBytecode,
+		contracts.RewardPoolContract:            contractsapi.RewardPool.DeployedBytecode,
+		contracts.NativeERC20TokenContract:      contractsapi.NativeERC20.DeployedBytecode,
+		contracts.ChildERC20PredicateContract:   contractsapi.ChildERC20Predicate.DeployedBytecode,
+		contracts.ChildERC721PredicateContract:  contractsapi.ChildERC721Predicate.DeployedBytecode,
+		contracts.ChildERC1155PredicateContract: contractsapi.ChildERC1155Predicate.DeployedBytecode,
+	} {
+		alloc[addr] = &chain.GenesisAccount{Balance: big.NewInt(0), Code: artifact}
+	}
+
+	transition := newTestTransition(t, alloc)
+	require.NoError(t, GenesisPostHookFactory(chainConfig, ConsensusName)(transition))
+
+	// the rewards of the epoch are still distributed (with zero reward), so the epoch ending block doesn't fail
+	commitEpoch := &contractsapi.CommitEpochValidatorSetFn{
+		ID:    big.NewInt(1),
+		Epoch: &contractsapi.Epoch{StartBlock: big.NewInt(1), EndBlock: big.NewInt(int64(config.EpochSize))},
+	}
+
+	input, err := commitEpoch.EncodeAbi()
+	require.NoError(t, err)
+	require.NoError(t, initContract(contracts.SystemCaller, contracts.ValidatorSetContract, input,
+		"ValidatorSet", transition))
+
+	distributeRewards := &contractsapi.DistributeRewardForRewardPoolFn{
+		EpochID: big.NewInt(1),
+		Uptime: []*contractsapi.Uptime{
+			{Validator: config.InitialValidatorSet[0].Address, SignedBlocks: big.NewInt(int64(config.EpochSize))},
+		},
+	}
+
+	input, err = distributeRewards.EncodeAbi()
+	require.NoError(t, err)
+	require.NoError(t, initContract(contracts.SystemCaller, contracts.RewardPoolContract, input,
+		"RewardPool", transition))
+}
@@ -6,6 +6,7 @@ import (
 	"strconv"
 	"testing"
 
+	"github.com/hashicorp/go-hclog"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/umbracle/ethgo"
@@ -343,7 +344,7 @@ func TestIntegration_CommitEpoch(t *testing.T) {
 		err = initContract(contracts.SystemCaller, contracts.ValidatorSetContract, initInput, "ChildValidatorSet", transition)
 		require.NoError(t, err)
 
-		initInput, err = getInitRewardPoolInput(polyBFTConfig)
+		initInput, err = getInitRewardPoolInput(polyBFTConfig, hclog.NewNullLogger())
 		require.NoError(t, err)
 
 		// init RewardPool
@@ -403,6 +404,109 @@ func TestIntegration_CommitEpoch(t *testing.T) {
 	}
 }
 
+func TestIntegration_DistributeRewardsFromApprovedWallet(t *testing.T) {
+	t.Parallel()
+
+	walletBalance := ethgo.Ether(5)
+	walletAddress := types.StringToAddress("1234889893")
+
+	cases := []struct {
+		name          string
+		rewardsConfig *RewardsConfig
+	}{
+		{
+			name: "unlimited reward wallet",
+			rewardsConfig: &RewardsConfig{
+				TokenAddress:  contracts.NativeERC20TokenContract,
+				WalletAddress: walletAddress,
+			},
+		},
+		{
+			name: "fees reward source",
+			rewardsConfig: &RewardsConfig{
+				TokenAddress:  contracts.NativeERC20TokenContract,
+				WalletAddress: walletAddress,
+				WalletAmount:  walletBalance,
+				RewardSource:  RewardSourceFees,
+			},
+		},
+	}
+
+	for _, c := range cases {
+		c := c
+
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			currentValidators := validator.NewTestValidatorsWithAliases(t,
+				[]string{"A", "B", "C", "D"}, []uint64{100, 100, 100, 100})
+			accSet := currentValidators.GetPublicIdentities()
+
+			// the wallet is funded by the premine, not by the genesis post hook
+			alloc := map[types.Address]*chain.GenesisAccount{
+				contracts.ValidatorSetContract:     {Code: contractsapi.ValidatorSet.DeployedBytecode},
+				contracts.RewardPoolContract:       {Code: contractsapi.RewardPool.DeployedBytecode},
+				contracts.NativeERC20TokenContract: {Code: contractsapi.NativeERC20.DeployedBytecode},
+				walletAddress:                      {Balance: walletBalance},
+			}
+
+			initValidators := make([]*validator.GenesisValidator, accSet.Len())
+			for i, val := range accSet {
+				alloc[val.Address] = &chain.GenesisAccount{Balance: val.VotingPower}
+				initValidators[i] = &validator.GenesisValidator{
+					Address: val.Address,
+					Balance: val.VotingPower,
+					Stake:   val.VotingPower,
+					BlsKey:  hex.EncodeToString(val.BlsKey.Marshal()),
+				}
+			}
+
+			polyBFTConfig := PolyBFTConfig{
+				InitialValidatorSet: initValidators,
+				EpochSize:           10,
+				SprintSize:          5,
+				EpochReward:         ethgo.Ether(1).Uint64(),
+				Governance:          accSet.GetAddresses()[0],
+				RewardConfig:        c.rewardsConfig,
+				Bridge:              &BridgeConfig{CustomSupernetManagerAddr: types.StringToAddress("0x12312451")},
+			}
+
+			transition := newTestTransition(t, alloc)
+
+			input, err := getInitValidatorSetInput(polyBFTConfig)
+			require.NoError(t, err)
+			require.NoError(t, initContract(contracts.SystemCaller, contracts.ValidatorSetContract,
+				input, "ValidatorSet", transition))
+
+			input, err = getInitRewardPoolInput(polyBFTConfig, hclog.NewNullLogger())
+			require.NoError(t, err)
+			require.NoError(t, initContract(contracts.SystemCaller, contracts.RewardPoolContract,
+				input, "RewardPool", transition))
+
+			// the same approval as the one of the genesis post hook
+			require.NoError(t, approveRewardPool(&polyBFTConfig, transition))
+
+			input, err = createTestCommitEpochInput(t, 1, polyBFTConfig.EpochSize).EncodeAbi()
+			require.NoError(t, err)
+
+			result := transition.Call2(contracts.SystemCaller, contracts.ValidatorSetContract, input,
+				big.NewInt(0), 10000000000)
+			require.NoError(t, result.Err)
+
+			// the epoch ending reward distribution must not revert for the lack of the allowance
+			input, err = createTestDistributeRewardsInput(t, 1, accSet, polyBFTConfig.EpochSize).EncodeAbi()
+			require.NoError(t, err)
+
+			result = transition.Call2(contracts.SystemCaller, contracts.RewardPoolContract, input,
+				big.NewInt(0), 10000000000)
+			require.NoError(t, result.Err)
+			require.True(t, result.Succeeded())
+
+			require.Equal(t, -1, transition.GetBalance(walletAddress).Cmp(walletBalance))
+		})
+	}
+}
+
 func deployAndInitContract(t *testing.T, transition *state.Transition, scArtifact *artifact.Artifact, sender types.Address,
 	initCallback func() ([]byte, error)) types.Address {
 	t.Helper()
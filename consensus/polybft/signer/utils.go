@@ -3,6 +3,7 @@ package bls
 import (
 	"bytes"
 	"crypto/rand"
+	"io"
 	"math/big"
 
 	"github.com/0xPolygon/polygon-edge/types"
@@ -16,7 +17,13 @@ var (
 
 // GenerateBlsKey creates a random private and its corresponding public keys
 func GenerateBlsKey() (*PrivateKey, error) {
-	s, err := randomK(rand.Reader)
+	return GenerateBlsKeyFromReader(rand.Reader)
+}
+
+// GenerateBlsKeyFromReader creates the private key using the randomness of the given reader.
+// The same reader output results in the same key, which makes the keys reproducible (e.g. in tests).
+func GenerateBlsKeyFromReader(r io.Reader) (*PrivateKey, error) {
+	s, err := randomK(r)
 	if err != nil {
 		return nil, err
 	}
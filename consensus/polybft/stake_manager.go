@@ -55,7 +55,7 @@ type stakeManager struct {
 	key                     ethgo.Key
 	validatorSetContract    types.Address
 	supernetManagerContract types.Address
-	maxValidatorSetSize     int
+	maxValidatorSetSizeAt   func(epoch uint64) uint64
 }
 
 // newStakeManager returns a new instance of stake manager
@@ -65,7 +65,7 @@ func newStakeManager(
 	rootchainRelayer txrelayer.TxRelayer,
 	key ethgo.Key,
 	validatorSetAddr, supernetManagerAddr types.Address,
-	maxValidatorSetSize int,
+	maxValidatorSetSizeAt func(epoch uint64) uint64,
 ) *stakeManager {
 	return &stakeManager{
 		logger:                  logger,
@@ -74,7 +74,7 @@ func newStakeManager(
 		key:                     key,
 		validatorSetContract:    validatorSetAddr,
 		supernetManagerContract: supernetManagerAddr,
-		maxValidatorSetSize:     maxValidatorSetSize,
+		maxValidatorSetSizeAt:   maxValidatorSetSizeAt,
 	}
 }
 
@@ -167,8 +167,8 @@ func (s *stakeManager) UpdateValidatorSet(
 	// stake map that holds stakes for all validators
 	stakeMap := fullValidatorSet.Validators
 
-	// slice of all validator set
-	newValidatorSet := stakeMap.getSorted(s.maxValidatorSetSize)
+	// slice of all validator set, capped by the maximum size of the next epoch validator set
+	newValidatorSet := stakeMap.getSorted(int(s.maxValidatorSetSizeAt(epoch + 1)))
 	// set of all addresses that will be in next validator set
 	addressesSet := make(map[types.Address]struct{}, len(newValidatorSet))
 
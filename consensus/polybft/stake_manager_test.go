@@ -23,9 +23,9 @@ func TestStakeManager_PostEpoch(t *testing.T) {
 	state := newTestState(t)
 
 	stakeManager := &stakeManager{
-		logger:              hclog.NewNullLogger(),
-		state:               state,
-		maxValidatorSetSize: 10,
+		logger:                hclog.NewNullLogger(),
+		state:                 state,
+		maxValidatorSetSizeAt: func(uint64) uint64 { return 10 },
 	}
 
 	t.Run("Not first epoch", func(t *testing.T) {
@@ -76,7 +76,7 @@ func TestStakeManager_PostBlock(t *testing.T) {
 			nil,
 			wallet.NewEcdsaSigner(validators.GetValidator("A").Key()),
 			types.StringToAddress("0x0001"), types.StringToAddress("0x0002"),
-			5,
+			func(uint64) uint64 { return 5 },
 		)
 
 		// insert initial full validator set
@@ -130,7 +130,7 @@ func TestStakeManager_PostBlock(t *testing.T) {
 			nil,
 			wallet.NewEcdsaSigner(validators.GetValidator("A").Key()),
 			types.StringToAddress("0x0001"), types.StringToAddress("0x0002"),
-			5,
+			func(uint64) uint64 { return 5 },
 		)
 
 		// insert initial full validator set
@@ -194,7 +194,7 @@ func TestStakeManager_PostBlock(t *testing.T) {
 			txRelayerMock,
 			wallet.NewEcdsaSigner(validators.GetValidator("A").Key()),
 			types.StringToAddress("0x0001"), types.StringToAddress("0x0002"),
-			5,
+			func(uint64) uint64 { return 5 },
 		)
 
 		// insert initial full validator set
@@ -251,7 +251,7 @@ func TestStakeManager_UpdateValidatorSet(t *testing.T) {
 		nil,
 		wallet.NewEcdsaSigner(validators.GetValidator("A").Key()),
 		types.StringToAddress("0x0001"), types.StringToAddress("0x0002"),
-		10,
+		func(uint64) uint64 { return 10 },
 	)
 
 	t.Run("UpdateValidatorSet - only update", func(t *testing.T) {
@@ -349,7 +349,7 @@ func TestStakeManager_UpdateValidatorSet(t *testing.T) {
 
 	t.Run("UpdateValidatorSet - max validator set size reached", func(t *testing.T) {
 		// because we now have 5 validators, and the new validator has more stake
-		stakeManager.maxValidatorSetSize = 4
+		stakeManager.maxValidatorSetSizeAt = func(uint64) uint64 { return 4 }
 
 		fullValidatorSet := validators.GetPublicIdentities().Copy()
 		validatorToAdd := fullValidatorSet[0]
@@ -0,0 +1,224 @@
+package polybft
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"os"
+	"strconv"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/validator"
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/wallet"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testGenesisChainID  = 100
+	testGenesisGasLimit = 5242880
+	testRootchainURL    = "http://127.0.0.1:8545"
+)
+
+// testGenesisParams holds the parameters of the genesis generated by NewTestPolyBFTGenesis
+type testGenesisParams struct {
+	withBridge  bool
+	nativeToken *TokenConfig
+	epochSize   uint64
+	sprintSize  uint64
+	seed        *int64
+}
+
+// TestGenesisOption customizes the genesis generated by NewTestPolyBFTGenesis
+type TestGenesisOption func(params *testGenesisParams)
+
+// WithTestBridge enables the bridge, populating the rootchain contract addresses with dummy values
+func WithTestBridge() TestGenesisOption {
+	return func(params *testGenesisParams) {
+		params.withBridge = true
+	}
+}
+
+// WithTestNativeToken sets the native token config
+func WithTestNativeToken(nativeToken *TokenConfig) TestGenesisOption {
+	return func(params *testGenesisParams) {
+		params.nativeToken = nativeToken
+	}
+}
+
+// WithTestEpochSize sets the epoch and sprint sizes (in blocks)
+func WithTestEpochSize(epochSize, sprintSize uint64) TestGenesisOption {
+	return func(params *testGenesisParams) {
+		params.epochSize = epochSize
+		params.sprintSize = sprintSize
+	}
+}
+
+// WithTestSeed makes the validators key material reproducible, see NewSeededValidatorSet
+func WithTestSeed(seed int64) TestGenesisOption {
+	return func(params *testGenesisParams) {
+		params.seed = &seed
+	}
+}
+
+// TestValidator is the key material of the genesis validator generated by NewTestPolyBFTGenesis
+type TestValidator = validator.TestValidator
+
+// NewTestPolyBFTGenesis generates the polybft chain with the given number of genesis validators,
+// based on MinimalValidPolyBFTConfig. It returns the chain, ready to be used as a genesis, along with
+// the validators key material (in the same order as they are listed in the initial validator set).
+// The voting power of each validator is its stake, the same way as it is encoded in the genesis extra data.
+// The test fails if the options result in an invalid config (e.g. mintable native token along with the bridge).
+func NewTestPolyBFTGenesis(tb testing.TB, validatorCount int,
+	opts ...TestGenesisOption) (*chain.Chain, []TestValidator) {
+	tb.Helper()
+
+	require.Positive(tb, validatorCount, "validator count must be positive")
+
+	config := MinimalValidPolyBFTConfig()
+	params := &testGenesisParams{
+		nativeToken: &TokenConfig{Name: "Polygon", Symbol: "MATIC", Decimals: 18},
+		epochSize:   config.EpochSize,
+		sprintSize:  config.SprintSize,
+	}
+
+	for _, opt := range opts {
+		opt(params)
+	}
+
+	generateAccount := wallet.GenerateAccount
+	if params.seed != nil {
+		r := rand.New(rand.NewSource(*params.seed)) //nolint:gosec
+		generateAccount = func() (*wallet.Account, error) {
+			return wallet.GenerateAccountFromReader(r)
+		}
+	}
+
+	validators, err := newTestGenesisValidators(validatorCount, generateAccount)
+	require.NoError(tb, err)
+
+	config.InitialValidatorSet = make([]*validator.GenesisValidator, len(validators))
+	config.EpochSize = params.epochSize
+	config.SprintSize = params.sprintSize
+	config.NativeTokenConfig = params.nativeToken
+
+	for i := range validators {
+		config.InitialValidatorSet[i] = validators[i].ParamsValidator()
+	}
+
+	if params.withBridge {
+		config.Bridge = newTestBridgeConfig()
+	}
+
+	_, err = SplitValidationWarnings(config.Validate())
+	require.NoError(tb, err, "invalid test genesis config")
+
+	extraData, err := config.EncodeGenesisExtraData()
+	require.NoError(tb, err)
+
+	allocs := make(map[types.Address]*chain.GenesisAccount, len(config.InitialValidatorSet))
+	for _, v := range config.InitialValidatorSet {
+		allocs[v.Address] = &chain.GenesisAccount{Balance: v.Balance}
+	}
+
+	genesis := newTestChain(testGenesisChainID, &config)
+	genesis.Genesis = &chain.Genesis{
+		GasLimit:  testGenesisGasLimit,
+		Alloc:     allocs,
+		ExtraData: extraData,
+		Mixhash:   PolyBFTMixDigest,
+	}
+
+	return genesis, validators
+}
+
+// newTestChain creates the chain with the given chain ID and polybft config, without the genesis
+func newTestChain(chainID int64, config *PolyBFTConfig) *chain.Chain {
+	return &chain.Chain{
+		Name: "polybft-test",
+		Params: &chain.Params{
+			ChainID: chainID,
+			Forks:   chain.AllForksEnabled,
+			Engine: map[string]interface{}{
+				ConsensusName: config,
+			},
+		},
+	}
+}
+
+// writeTestChainConfig writes the chain config with the given chain ID and polybft config to the path
+func writeTestChainConfig(tb testing.TB, path string, chainID int64, config *PolyBFTConfig) {
+	tb.Helper()
+
+	data, err := json.Marshal(newTestChain(chainID, config))
+	require.NoError(tb, err)
+	require.NoError(tb, os.WriteFile(path, data, 0600))
+}
+
+// newTestBridgeConfig creates the bridge config with dummy rootchain contract addresses
+func newTestBridgeConfig() *BridgeConfig {
+	bridge := &BridgeConfig{
+		StateSenderAddr:           types.StringToAddress("0x1001"),
+		CheckpointManagerAddr:     types.StringToAddress("0x1002"),
+		ExitHelperAddr:            types.StringToAddress("0x1003"),
+		RootERC20PredicateAddr:    types.StringToAddress("0x1004"),
+		RootNativeERC20Addr:       types.StringToAddress("0x1005"),
+		RootERC721Addr:            types.StringToAddress("0x1006"),
+		RootERC721PredicateAddr:   types.StringToAddress("0x1007"),
+		RootERC1155Addr:           types.StringToAddress("0x1008"),
+		RootERC1155PredicateAddr:  types.StringToAddress("0x1009"),
+		CustomSupernetManagerAddr: types.StringToAddress("0x100a"),
+		StakeManagerAddr:          types.StringToAddress("0x100b"),
+		ProxyAdminAddr:            types.StringToAddress("0x100c"),
+		JSONRPCEndpoint:           testRootchainURL,
+		CheckpointInterval:        defaultCheckpointInterval,
+		MaxBridgeBatchSize:        maxCommitmentSize,
+	}
+
+	for _, addr := range bridge.mandatoryAddresses() {
+		bridge.SetStartBlock(addr, 0)
+	}
+
+	return bridge
+}
+
+// NewSeededValidatorSet generates the given number of genesis validators, whose addresses and BLS keys
+// are derived from the seed. The same seed always results in the same validators (e.g. for snapshot tests).
+// It panics if the validator accounts can't be generated, which can't happen while reading from the seeded source.
+func NewSeededValidatorSet(seed int64, count int) []*validator.GenesisValidator {
+	r := rand.New(rand.NewSource(seed)) //nolint:gosec
+
+	validators, err := newTestGenesisValidators(count, func() (*wallet.Account, error) {
+		return wallet.GenerateAccountFromReader(r)
+	})
+	if err != nil {
+		panic(fmt.Sprintf("failed to generate seeded validator set: %v", err))
+	}
+
+	genesisValidators := make([]*validator.GenesisValidator, len(validators))
+	for i := range validators {
+		genesisValidators[i] = validators[i].ParamsValidator()
+	}
+
+	return genesisValidators
+}
+
+// newTestGenesisValidators generates the given number of test validators by the given account generator.
+// Voting power of each validator is set to its genesis stake (see validator.TestValidator.ParamsValidator).
+func newTestGenesisValidators(count int,
+	generateAccount func() (*wallet.Account, error)) ([]TestValidator, error) {
+	validators := make([]TestValidator, count)
+
+	for i := range validators {
+		account, err := generateAccount()
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate test validator account: %w", err)
+		}
+
+		validators[i] = TestValidator{Alias: strconv.Itoa(i), Account: account}
+		validators[i].VotingPower = validators[i].ParamsValidator().Stake.Uint64()
+	}
+
+	return validators, nil
+}
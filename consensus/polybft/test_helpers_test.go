@@ -0,0 +1,98 @@
+package polybft
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewTestPolyBFTGenesis(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Defaults", func(t *testing.T) {
+		t.Parallel()
+
+		genesis, validators := NewTestPolyBFTGenesis(t, 4)
+		require.Len(t, validators, 4)
+
+		config, err := GetPolyBFTConfig(genesis)
+		require.NoError(t, err)
+		require.Len(t, config.InitialValidatorSet, 4)
+		require.Equal(t, DefaultPolyBFTConfig().EpochSize, config.EpochSize)
+		require.Equal(t, DefaultPolyBFTConfig().SprintSize, config.SprintSize)
+		require.Nil(t, config.Bridge)
+		require.Equal(t, NativeTokenModeNonBridgedFixed, config.NativeTokenMode())
+
+		for i, v := range validators {
+			require.Equal(t, v.Address(), config.InitialValidatorSet[i].Address)
+			require.Equal(t, config.InitialValidatorSet[i].Stake.Uint64(), v.VotingPower)
+			require.Contains(t, genesis.Genesis.Alloc, v.Address())
+		}
+
+		// voting powers of the extra data are the stakes of the initial validators
+		extraValidators, err := DecodeGenesisExtraData(genesis.Genesis.ExtraData)
+		require.NoError(t, err)
+		require.Len(t, extraValidators, 4)
+
+		for i, v := range extraValidators {
+			require.Equal(t, config.InitialValidatorSet[i].Address, v.Address)
+			require.Equal(t, config.InitialValidatorSet[i].Stake, v.Stake)
+		}
+	})
+
+	t.Run("Bridge with bridged native token", func(t *testing.T) {
+		t.Parallel()
+
+		genesis, _ := NewTestPolyBFTGenesis(t, 1, WithTestBridge())
+
+		config, err := GetPolyBFTConfig(genesis)
+		require.NoError(t, err)
+		require.NotNil(t, config.Bridge)
+		require.Equal(t, NativeTokenModeBridged, config.NativeTokenMode())
+	})
+
+	t.Run("Mintable native token and custom epoch size", func(t *testing.T) {
+		t.Parallel()
+
+		nativeToken := &TokenConfig{Name: "Test", Symbol: "TST", Decimals: 6, IsMintable: true}
+		genesis, _ := NewTestPolyBFTGenesis(t, 2, WithTestNativeToken(nativeToken), WithTestEpochSize(20, 4))
+
+		config, err := GetPolyBFTConfig(genesis)
+		require.NoError(t, err)
+		require.Equal(t, *nativeToken, *config.NativeTokenConfig)
+		require.Equal(t, NativeTokenModeMintable, config.NativeTokenMode())
+		require.Nil(t, config.Bridge)
+		require.Equal(t, uint64(20), config.EpochSize)
+		require.Equal(t, uint64(4), config.SprintSize)
+	})
+}
+
+func TestNewSeededValidatorSet(t *testing.T) {
+	t.Parallel()
+
+	encode := func(validators interface{}) []byte {
+		data, err := json.Marshal(validators)
+		require.NoError(t, err)
+
+		return data
+	}
+
+	validators := NewSeededValidatorSet(42, 4)
+	require.Len(t, validators, 4)
+	require.Equal(t, encode(validators), encode(NewSeededValidatorSet(42, 4)))
+	require.NotEqual(t, encode(validators), encode(NewSeededValidatorSet(43, 4)))
+
+	addresses := make(map[string]struct{}, len(validators))
+	for _, v := range validators {
+		require.NotEmpty(t, v.BlsKey)
+		addresses[v.Address.String()] = struct{}{}
+	}
+
+	require.Len(t, addresses, 4)
+
+	// seeded genesis is reproducible as well
+	first, _ := NewTestPolyBFTGenesis(t, 2, WithTestSeed(7))
+	second, _ := NewTestPolyBFTGenesis(t, 2, WithTestSeed(7))
+	require.Equal(t, encode(first), encode(second))
+}
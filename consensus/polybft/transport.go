@@ -42,6 +42,9 @@ func (p *Polybft) subscribeToIbftTopic() error {
 
 // createTopics create all topics for a PolyBft instance
 func (p *Polybft) createTopics() (err error) {
+	// the bridge topic is created for the bridge which isn't active yet as well, since the topics
+	// are not created once the node is running. The votes gossiped on it before the activation
+	// only prepare the commitments, which are not included into the blocks until the bridge is active.
 	if p.consensusConfig.IsBridgeEnabled() {
 		p.bridgeTopic, err = p.config.Network.NewTopic(bridgeProto, &polybftProto.TransportMessage{})
 		if err != nil {
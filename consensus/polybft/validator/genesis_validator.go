@@ -5,11 +5,15 @@ import (
 	"encoding/json"
 	"fmt"
 	"math/big"
+	"strings"
 
 	bls "github.com/0xPolygon/polygon-edge/consensus/polybft/signer"
 	"github.com/0xPolygon/polygon-edge/types"
 )
 
+// BLSPublicKeySize is the size of the marshaled BLS public key (G2 point) in bytes
+const BLSPublicKeySize = 128
+
 // GenesisValidator represents public information about validator accounts which are the part of genesis
 type GenesisValidator struct {
 	Address       types.Address
@@ -47,7 +51,13 @@ func (v *GenesisValidator) UnmarshalJSON(data []byte) error {
 	}
 
 	v.Address = raw.Address
-	v.BlsKey = raw.BlsKey
+	// hex encoded BLS key is normalized, so that the encoding doesn't depend on the input casing and 0x prefix.
+	// Malformed key is only lowercased, it is reported by the config validation along with the validator address.
+	v.BlsKey, err = NormalizeBLSKey(raw.BlsKey)
+	if err != nil {
+		v.BlsKey = strings.ToLower(raw.BlsKey)
+	}
+
 	v.MultiAddr = raw.MultiAddr
 
 	v.Balance, err = types.ParseUint256orHex(raw.Balance)
@@ -65,7 +75,12 @@ func (v *GenesisValidator) UnmarshalJSON(data []byte) error {
 
 // UnmarshalBLSPublicKey unmarshals the hex encoded BLS public key
 func (v *GenesisValidator) UnmarshalBLSPublicKey() (*bls.PublicKey, error) {
-	decoded, err := hex.DecodeString(v.BlsKey)
+	key, err := NormalizeBLSKey(v.BlsKey)
+	if err != nil {
+		return nil, err
+	}
+
+	decoded, err := hex.DecodeString(key)
 	if err != nil {
 		return nil, err
 	}
@@ -73,6 +88,26 @@ func (v *GenesisValidator) UnmarshalBLSPublicKey() (*bls.PublicKey, error) {
 	return bls.UnmarshalPublicKey(decoded)
 }
 
+// NormalizeBLSKey returns the canonical form of the hex encoded BLS public key, that is lowercase hex without
+// the 0x prefix. It fails if the key is not hex encoded or if it doesn't have BLSPublicKeySize bytes.
+func NormalizeBLSKey(key string) (string, error) {
+	trimmed := strings.TrimSpace(key)
+	if len(trimmed) >= 2 && trimmed[0] == '0' && (trimmed[1] == 'x' || trimmed[1] == 'X') {
+		trimmed = trimmed[2:]
+	}
+
+	decoded, err := hex.DecodeString(trimmed)
+	if err != nil {
+		return "", fmt.Errorf("BLS public key is not hex encoded: %w", err)
+	}
+
+	if len(decoded) != BLSPublicKeySize {
+		return "", fmt.Errorf("BLS public key must have %d bytes, but it has %d bytes", BLSPublicKeySize, len(decoded))
+	}
+
+	return hex.EncodeToString(decoded), nil
+}
+
 // ToValidatorMetadata creates ValidatorMetadata instance
 func (v *GenesisValidator) ToValidatorMetadata() (*ValidatorMetadata, error) {
 	blsKey, err := v.UnmarshalBLSPublicKey()
@@ -0,0 +1,52 @@
+package validator
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenesisValidator_NormalizeBLSKey(t *testing.T) {
+	t.Parallel()
+
+	key := NewTestValidators(t, 1).GetParamValidators()[0].BlsKey
+
+	for _, input := range []string{key, "0x" + key, "0X" + strings.ToUpper(key), " " + key + " "} {
+		normalized, err := NormalizeBLSKey(input)
+		require.NoError(t, err, input)
+		require.Equal(t, key, normalized, input)
+	}
+
+	_, err := NormalizeBLSKey("0xzz")
+	require.ErrorContains(t, err, "BLS public key is not hex encoded")
+
+	_, err = NormalizeBLSKey(key[:len(key)-2])
+	require.ErrorContains(t, err, "BLS public key must have 128 bytes, but it has 127 bytes")
+}
+
+func TestGenesisValidator_UnmarshalNormalizesBLSKey(t *testing.T) {
+	t.Parallel()
+
+	original := NewTestValidators(t, 1).GetParamValidators()[0]
+	addr := types.StringToAddress("1")
+
+	var decoded GenesisValidator
+
+	data := `{"address":"` + addr.String() + `","blsKey":"0x` + strings.ToUpper(original.BlsKey) + `"}`
+	require.NoError(t, json.Unmarshal([]byte(data), &decoded))
+	require.Equal(t, original.BlsKey, decoded.BlsKey)
+
+	_, err := decoded.UnmarshalBLSPublicKey()
+	require.NoError(t, err)
+
+	// malformed key is kept (lowercased), so that it can be reported by the validation
+	data = `{"address":"` + addr.String() + `","blsKey":"0xABC"}`
+	require.NoError(t, json.Unmarshal([]byte(data), &decoded))
+	require.Equal(t, "0xabc", decoded.BlsKey)
+
+	_, err = decoded.UnmarshalBLSPublicKey()
+	require.ErrorContains(t, err, "BLS public key is not hex encoded")
+}
@@ -0,0 +1,84 @@
+package polybft
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+
+	bls "github.com/0xPolygon/polygon-edge/consensus/polybft/signer"
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/validator"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/go-multierror"
+)
+
+// ValidatorSetBuilder assembles the initial validator set of the PolyBFTConfig,
+// keeping the address, BLS public key and stake of each validator aligned
+type ValidatorSetBuilder struct {
+	validators []*validator.GenesisValidator
+	addresses  map[types.Address]struct{}
+	errs       *multierror.Error
+}
+
+// NewValidatorSetBuilder creates an empty ValidatorSetBuilder
+func NewValidatorSetBuilder() *ValidatorSetBuilder {
+	return &ValidatorSetBuilder{
+		addresses: map[types.Address]struct{}{},
+	}
+}
+
+// AddValidator appends the validator with provided address, marshaled BLS public key and stake to the set.
+// Invalid entries are not added and they are reported by Build.
+func (b *ValidatorSetBuilder) AddValidator(addr types.Address, blsKey []byte, stake *big.Int) *ValidatorSetBuilder {
+	if _, exists := b.addresses[addr]; exists {
+		b.errs = multierror.Append(b.errs, fmt.Errorf("duplicate validator address %s", addr))
+
+		return b
+	}
+
+	if len(blsKey) != validator.BLSPublicKeySize {
+		b.errs = multierror.Append(b.errs, fmt.Errorf("validator %s has invalid BLS public key length %d (expected %d)",
+			addr, len(blsKey), validator.BLSPublicKeySize))
+
+		return b
+	}
+
+	if _, err := bls.UnmarshalPublicKey(blsKey); err != nil {
+		b.errs = multierror.Append(b.errs, fmt.Errorf("validator %s has invalid BLS public key: %w", addr, err))
+
+		return b
+	}
+
+	if stake == nil || stake.Sign() < 0 {
+		b.errs = multierror.Append(b.errs, fmt.Errorf("validator %s has invalid stake %v", addr, stake))
+
+		return b
+	}
+
+	b.addresses[addr] = struct{}{}
+	b.validators = append(b.validators, &validator.GenesisValidator{
+		Address: addr,
+		BlsKey:  hex.EncodeToString(blsKey),
+		Balance: big.NewInt(0),
+		Stake:   new(big.Int).Set(stake),
+	})
+
+	return b
+}
+
+// Build returns the validators in the order they were added,
+// which can be assigned directly to PolyBFTConfig.InitialValidatorSet.
+// It returns an error if any of the added validators was invalid or if no validators were added.
+func (b *ValidatorSetBuilder) Build() ([]*validator.GenesisValidator, error) {
+	if err := b.errs.ErrorOrNil(); err != nil {
+		return nil, err
+	}
+
+	if len(b.validators) == 0 {
+		return nil, fmt.Errorf("validator set is empty")
+	}
+
+	validators := make([]*validator.GenesisValidator, len(b.validators))
+	copy(validators, b.validators)
+
+	return validators, nil
+}
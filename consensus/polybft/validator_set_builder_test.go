@@ -0,0 +1,85 @@
+package polybft
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	bls "github.com/0xPolygon/polygon-edge/consensus/polybft/signer"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidatorSetBuilder(t *testing.T) {
+	t.Parallel()
+
+	newBLSKey := func(t *testing.T) []byte {
+		t.Helper()
+
+		key, err := bls.GenerateBlsKey()
+		require.NoError(t, err)
+
+		return key.PublicKey().Marshal()
+	}
+
+	t.Run("valid validators", func(t *testing.T) {
+		t.Parallel()
+
+		firstKey, secondKey := newBLSKey(t), newBLSKey(t)
+
+		validators, err := NewValidatorSetBuilder().
+			AddValidator(types.StringToAddress("1"), firstKey, big.NewInt(10)).
+			AddValidator(types.StringToAddress("2"), secondKey, big.NewInt(20)).
+			Build()
+		require.NoError(t, err)
+		require.Len(t, validators, 2)
+
+		require.Equal(t, types.StringToAddress("1"), validators[0].Address)
+		require.Equal(t, hex.EncodeToString(firstKey), validators[0].BlsKey)
+		require.Equal(t, big.NewInt(10), validators[0].Stake)
+		require.Equal(t, types.StringToAddress("2"), validators[1].Address)
+		require.Equal(t, big.NewInt(20), validators[1].Stake)
+
+		blsKey, err := validators[1].UnmarshalBLSPublicKey()
+		require.NoError(t, err)
+		require.Equal(t, secondKey, blsKey.Marshal())
+
+		config := &PolyBFTConfig{InitialValidatorSet: validators, MaxValidatorSetSize: 100}
+		require.Equal(t, uint64(2), config.ActiveValidatorCount())
+	})
+
+	t.Run("duplicate address", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := NewValidatorSetBuilder().
+			AddValidator(types.StringToAddress("1"), newBLSKey(t), big.NewInt(10)).
+			AddValidator(types.StringToAddress("1"), newBLSKey(t), big.NewInt(20)).
+			Build()
+		require.ErrorContains(t, err, "duplicate validator address")
+	})
+
+	t.Run("invalid BLS key length", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := NewValidatorSetBuilder().
+			AddValidator(types.StringToAddress("1"), make([]byte, 64), big.NewInt(10)).
+			Build()
+		require.ErrorContains(t, err, "invalid BLS public key length 64")
+	})
+
+	t.Run("invalid stake", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := NewValidatorSetBuilder().
+			AddValidator(types.StringToAddress("1"), newBLSKey(t), big.NewInt(-1)).
+			Build()
+		require.ErrorContains(t, err, "invalid stake")
+	})
+
+	t.Run("empty set", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := NewValidatorSetBuilder().Build()
+		require.ErrorContains(t, err, "validator set is empty")
+	})
+}
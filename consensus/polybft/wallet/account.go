@@ -2,8 +2,11 @@ package wallet
 
 import (
 	"crypto/ecdsa"
+	"crypto/rand"
 	"encoding/hex"
 	"fmt"
+	"io"
+	"math/big"
 
 	bls "github.com/0xPolygon/polygon-edge/consensus/polybft/signer"
 	"github.com/0xPolygon/polygon-edge/secrets"
@@ -35,6 +38,31 @@ func GenerateAccount() (*Account, error) {
 	}, nil
 }
 
+// GenerateAccountFromReader generates the account using the randomness of the given reader.
+// The same reader output results in the same keys, which makes the accounts reproducible (e.g. in tests).
+func GenerateAccountFromReader(r io.Reader) (*Account, error) {
+	// the ECDSA key must be in range [1, N-1]
+	d, err := rand.Int(r, new(big.Int).Sub(wallet.S256.Params().N, big.NewInt(1)))
+	if err != nil {
+		return nil, fmt.Errorf("cannot generate key. error: %w", err)
+	}
+
+	key, err := wallet.NewWalletFromPrivKey(d.Add(d, big.NewInt(1)).FillBytes(make([]byte, 32)))
+	if err != nil {
+		return nil, fmt.Errorf("cannot generate key. error: %w", err)
+	}
+
+	blsKey, err := bls.GenerateBlsKeyFromReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("cannot generate bls key. error: %w", err)
+	}
+
+	return &Account{
+		Ecdsa: key,
+		Bls:   blsKey,
+	}, nil
+}
+
 // NewAccountFromSecret creates new account by using provided secretsManager
 func NewAccountFromSecret(secretsManager secrets.SecretsManager) (*Account, error) {
 	ecdsaKey, err := GetEcdsaFromSecret(secretsManager)
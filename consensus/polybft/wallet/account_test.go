@@ -2,6 +2,7 @@ package wallet
 
 import (
 	"fmt"
+	"math/rand"
 	"testing"
 
 	"github.com/0xPolygon/polygon-edge/secrets"
@@ -33,6 +34,24 @@ func TestAccount(t *testing.T) {
 	assert.Equal(t, privKeyMarshalled, privKeyMarshalled1)
 }
 
+func TestGenerateAccountFromReader(t *testing.T) {
+	t.Parallel()
+
+	generate := func(seed int64) *Account {
+		acc, err := GenerateAccountFromReader(rand.New(rand.NewSource(seed))) //nolint:gosec
+		require.NoError(t, err)
+
+		return acc
+	}
+
+	first, second, other := generate(1), generate(1), generate(2)
+
+	assert.Equal(t, first.Ecdsa.Address(), second.Ecdsa.Address())
+	assert.Equal(t, first.Bls.PublicKey().Marshal(), second.Bls.PublicKey().Marshal())
+	assert.NotEqual(t, first.Ecdsa.Address(), other.Ecdsa.Address())
+	assert.NotEqual(t, first.Bls.PublicKey().Marshal(), other.Bls.PublicKey().Marshal())
+}
+
 func newSecretsManagerMock() secrets.SecretsManager {
 	return &secretsManagerMock{cache: make(map[string][]byte)}
 }
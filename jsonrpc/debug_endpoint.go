@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/0xPolygon/polygon-edge/chain"
 	"github.com/0xPolygon/polygon-edge/helper/hex"
 	"github.com/0xPolygon/polygon-edge/state/runtime/tracer"
 	"github.com/0xPolygon/polygon-edge/state/runtime/tracer/structtracer"
@@ -21,6 +22,8 @@ var (
 	ErrTraceGenesisBlock = errors.New("genesis is not traceable")
 	// ErrNoConfig is an error returns when config is empty
 	ErrNoConfig = errors.New("missing config object")
+	// ErrNoChainConfig is an error returned when the chain config is not available
+	ErrNoChainConfig = errors.New("chain config is not available")
 )
 
 type debugBlockchainStore interface {
@@ -65,7 +68,8 @@ type debugStore interface {
 
 // Debug is the debug jsonrpc endpoint
 type Debug struct {
-	store debugStore
+	store       debugStore
+	chainParams *chain.Params
 }
 
 type TraceConfig struct {
@@ -76,6 +80,17 @@ type TraceConfig struct {
 	Timeout          *string `json:"timeout"`
 }
 
+// ChainConfig returns the chain params (including the consensus engine config) the node booted with.
+// The params are served as they were provided, so the secrets must be redacted beforehand
+// (see polybft.RedactChainParams).
+func (d *Debug) ChainConfig() (interface{}, error) {
+	if d.chainParams == nil {
+		return nil, ErrNoChainConfig
+	}
+
+	return d.chainParams, nil
+}
+
 func (d *Debug) TraceBlockByNumber(
 	blockNumber BlockNumber,
 	config *TraceConfig,
@@ -6,6 +6,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/0xPolygon/polygon-edge/chain"
 	"github.com/0xPolygon/polygon-edge/helper/hex"
 	"github.com/0xPolygon/polygon-edge/state/runtime/tracer"
 	"github.com/0xPolygon/polygon-edge/types"
@@ -269,7 +270,7 @@ func TestTraceBlockByNumber(t *testing.T) {
 		t.Run(test.name, func(t *testing.T) {
 			t.Parallel()
 
-			endpoint := &Debug{test.store}
+			endpoint := &Debug{store: test.store}
 
 			res, err := endpoint.TraceBlockByNumber(test.blockNumber, test.config)
 
@@ -338,7 +339,7 @@ func TestTraceBlockByHash(t *testing.T) {
 		t.Run(test.name, func(t *testing.T) {
 			t.Parallel()
 
-			endpoint := &Debug{test.store}
+			endpoint := &Debug{store: test.store}
 
 			res, err := endpoint.TraceBlockByHash(test.blockHash, test.config)
 
@@ -397,7 +398,7 @@ func TestTraceBlock(t *testing.T) {
 		t.Run(test.name, func(t *testing.T) {
 			t.Parallel()
 
-			endpoint := &Debug{test.store}
+			endpoint := &Debug{store: test.store}
 
 			res, err := endpoint.TraceBlock(test.input, test.config)
 
@@ -543,7 +544,7 @@ func TestTraceTransaction(t *testing.T) {
 		t.Run(test.name, func(t *testing.T) {
 			t.Parallel()
 
-			endpoint := &Debug{test.store}
+			endpoint := &Debug{store: test.store}
 
 			res, err := endpoint.TraceTransaction(test.txHash, test.config)
 
@@ -679,7 +680,7 @@ func TestTraceCall(t *testing.T) {
 		t.Run(test.name, func(t *testing.T) {
 			t.Parallel()
 
-			endpoint := &Debug{test.store}
+			endpoint := &Debug{store: test.store}
 
 			res, err := endpoint.TraceCall(test.arg, test.filter, test.config)
 
@@ -773,3 +774,16 @@ func Test_newTracer(t *testing.T) {
 		assert.NoError(t, err)
 	})
 }
+
+func TestDebugChainConfig(t *testing.T) {
+	t.Parallel()
+
+	_, err := (&Debug{}).ChainConfig()
+	assert.ErrorIs(t, err, ErrNoChainConfig)
+
+	params := &chain.Params{ChainID: 100, Engine: map[string]interface{}{"polybft": map[string]interface{}{}}}
+
+	res, err := (&Debug{chainParams: params}).ChainConfig()
+	assert.NoError(t, err)
+	assert.Equal(t, params, res)
+}
@@ -10,6 +10,7 @@ import (
 	"strings"
 	"unicode"
 
+	"github.com/0xPolygon/polygon-edge/chain"
 	"github.com/hashicorp/go-hclog"
 )
 
@@ -50,8 +51,9 @@ type Dispatcher struct {
 }
 
 type dispatcherParams struct {
-	chainID   uint64
-	chainName string
+	chainID     uint64
+	chainName   string
+	chainParams *chain.Params
 
 	priceLimit              uint64
 	jsonRPCBatchLengthLimit uint64
@@ -103,7 +105,8 @@ func (d *Dispatcher) registerEndpoints(store JSONRPCStore) error {
 		store,
 	}
 	d.endpoints.Debug = &Debug{
-		store,
+		store:       store,
+		chainParams: d.params.chainParams,
 	}
 
 	var err error
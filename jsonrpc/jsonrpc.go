@@ -9,6 +9,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/0xPolygon/polygon-edge/chain"
 	"github.com/0xPolygon/polygon-edge/versioning"
 	"github.com/gorilla/websocket"
 	"github.com/hashicorp/go-hclog"
@@ -64,6 +65,7 @@ type Config struct {
 	Addr                     *net.TCPAddr
 	ChainID                  uint64
 	ChainName                string
+	ChainParams              *chain.Params
 	AccessControlAllowOrigin []string
 	PriceLimit               uint64
 	BatchLengthLimit         uint64
@@ -78,6 +80,7 @@ func NewJSONRPC(logger hclog.Logger, config *Config) (*JSONRPC, error) {
 		&dispatcherParams{
 			chainID:                 config.ChainID,
 			chainName:               config.ChainName,
+			chainParams:             config.ChainParams,
 			priceLimit:              config.PriceLimit,
 			jsonRPCBatchLengthLimit: config.BatchLengthLimit,
 			blockRangeLimit:         config.BlockRangeLimit,
@@ -251,7 +251,7 @@ func NewServer(config *Config) (*Server, error) {
 	var initialStateRoot = types.ZeroHash
 
 	if ConsensusType(engineName) == PolyBFTConsensus {
-		polyBFTConfig, err := consensusPolyBFT.GetPolyBFTConfig(config.Chain)
+		polyBFTConfig, err := consensusPolyBFT.GetPolyBFTConfig(config.Chain, consensusPolyBFT.WithLogger(m.logger))
 		if err != nil {
 			return nil, err
 		}
@@ -262,8 +262,8 @@ func NewServer(config *Config) (*Server, error) {
 				return nil, fmt.Errorf("error on state root verification %w", err)
 			}
 
-			if checkedInitialTrieRoot != polyBFTConfig.InitialTrieRoot {
-				return nil, errors.New("invalid initial state root")
+			if err := polyBFTConfig.VerifyTrieRoot(checkedInitialTrieRoot); err != nil {
+				return nil, fmt.Errorf("invalid initial state root: %w", err)
 			}
 
 			logger.Info("Initial state root checked and correct")
@@ -620,14 +620,14 @@ func (s *Server) setupRelayer() error {
 		return fmt.Errorf("failed to create account from secret: %w", err)
 	}
 
-	polyBFTConfig, err := consensusPolyBFT.GetPolyBFTConfig(s.config.Chain)
+	polyBFTConfig, err := consensusPolyBFT.GetPolyBFTConfig(s.config.Chain, consensusPolyBFT.WithLogger(s.logger))
 	if err != nil {
 		return fmt.Errorf("failed to extract polybft config: %w", err)
 	}
 
 	trackerStartBlockConfig := map[types.Address]uint64{}
-	if polyBFTConfig.Bridge != nil {
-		trackerStartBlockConfig = polyBFTConfig.Bridge.EventTrackerStartBlocks
+	if bridge := polyBFTConfig.PrimaryBridge(); bridge != nil {
+		trackerStartBlockConfig = bridge.EventTrackerStartBlocks
 	}
 
 	relayer := statesyncrelayer.NewRelayer(
@@ -887,11 +887,18 @@ func (s *Server) setupJSONRPC() error {
 		BridgeDataProvider: s.consensus.GetBridgeProvider(),
 	}
 
+	// the chain params are served through the debug endpoint, hence the secrets must be redacted
+	chainParams, err := consensusPolyBFT.RedactChainParams(s.config.Chain.Params)
+	if err != nil {
+		return fmt.Errorf("failed to redact chain params: %w", err)
+	}
+
 	conf := &jsonrpc.Config{
 		Store:                    hub,
 		Addr:                     s.config.JSONRPC.JSONRPCAddr,
 		ChainID:                  uint64(s.config.Chain.Params.ChainID),
 		ChainName:                s.chain.Name,
+		ChainParams:              chainParams,
 		AccessControlAllowOrigin: s.config.JSONRPC.AccessControlAllowOrigin,
 		PriceLimit:               s.config.PriceLimit,
 		BatchLengthLimit:         s.config.JSONRPC.BatchLengthLimit,